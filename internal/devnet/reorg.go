@@ -0,0 +1,76 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package devnet
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/labstack/echo/v4"
+)
+
+// Snapshot saves the state of the devnet and returns the snapshot id.
+// This function should be used in the devnet environment.
+func Snapshot(ctx context.Context, rpcUrl string) (string, error) {
+	client, err := rpc.DialContext(ctx, rpcUrl)
+	if err != nil {
+		return "", fmt.Errorf("dial to %v: %w", rpcUrl, err)
+	}
+	defer client.Close()
+	var id string
+	if err := client.CallContext(ctx, &id, "evm_snapshot"); err != nil {
+		return "", fmt.Errorf("snapshot: %w", err)
+	}
+	return id, nil
+}
+
+// Revert reverts the devnet to the snapshot and mines a new block, so the blocks after the
+// snapshot are replaced by a block with another hash, like in a reorg.
+// Anvil removes the snapshot after reverting to it.
+// This function should be used in the devnet environment.
+func Revert(ctx context.Context, rpcUrl string, id string) error {
+	client, err := rpc.DialContext(ctx, rpcUrl)
+	if err != nil {
+		return fmt.Errorf("dial to %v: %w", rpcUrl, err)
+	}
+	defer client.Close()
+	var reverted bool
+	if err := client.CallContext(ctx, &reverted, "evm_revert", id); err != nil {
+		return fmt.Errorf("revert: %w", err)
+	}
+	if !reverted {
+		return fmt.Errorf("snapshot not found: %v", id)
+	}
+	if err := client.CallContext(ctx, nil, "evm_mine"); err != nil {
+		return fmt.Errorf("mine: %w", err)
+	}
+	return nil
+}
+
+// Register the admin endpoints that force a reorg in the devnet.
+// The snapshot endpoint saves the state of the devnet, and the revert endpoint goes back to it.
+func RegisterAdmin(e *echo.Echo, rpcUrl string) {
+	e.POST("/admin/anvil/snapshot", func(c echo.Context) error {
+		id, err := Snapshot(c.Request().Context(), rpcUrl)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		slog.Info("devnet: saved snapshot", "id", id)
+		return c.JSON(http.StatusOK, map[string]string{"id": id})
+	})
+	e.POST("/admin/anvil/revert", func(c echo.Context) error {
+		id := c.QueryParam("id")
+		if id == "" {
+			return c.String(http.StatusBadRequest, "missing snapshot id")
+		}
+		if err := Revert(c.Request().Context(), rpcUrl, id); err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		slog.Info("devnet: reverted to snapshot", "id", id)
+		return c.NoContent(http.StatusOK)
+	})
+}
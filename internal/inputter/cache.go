@@ -0,0 +1,142 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package inputter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Permissions of the cache directory and files.
+const (
+	cacheDirPermissions  = 0755
+	cacheFilePermissions = 0644
+)
+
+// Input fetched from the InputAdded event, with the header fields of its block.
+type CachedInput struct {
+	Index       uint64         `json:"index"`
+	Sender      common.Address `json:"sender"`
+	Payload     hexutil.Bytes  `json:"payload"`
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockHash   common.Hash    `json:"blockHash"`
+	Timestamp   uint64         `json:"timestamp"`
+}
+
+// Inputs of an application fetched from a chain, persisted in a file.
+// The cache allows the inputter to resume from the last fetched block after restarting, and to
+// find the inputs orphaned by a reorg.
+type Cache struct {
+	path string
+
+	InputBox common.Address `json:"inputBox"`
+
+	// First block whose events were not fetched yet.
+	NextBlock uint64 `json:"nextBlock"`
+
+	// Hash of the last fetched block, used to detect reorgs.
+	LastHash common.Hash `json:"lastHash"`
+
+	Inputs []CachedInput `json:"inputs"`
+}
+
+// Get the default cache directory in the user cache directory; return empty if there is none.
+func DefaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "nonodo", "inputs")
+}
+
+// Load the cache of the application in the chain from the directory.
+// If there is no cache or it was created for another input box, return an empty cache.
+func LoadCache(
+	dir string,
+	chainID *big.Int,
+	application common.Address,
+	inputBox common.Address,
+) (*Cache, error) {
+	name := fmt.Sprintf("%v-%v.json", chainID, strings.ToLower(application.Hex()))
+	cache := &Cache{
+		path:     filepath.Join(dir, name),
+		InputBox: inputBox,
+	}
+	data, err := os.ReadFile(cache.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("inputter: failed to read cache: %w", err)
+	}
+	var stored Cache
+	if err := json.Unmarshal(data, &stored); err != nil {
+		slog.Warn("inputter: ignoring invalid cache", "path", cache.path, "error", err)
+		return cache, nil
+	}
+	if stored.InputBox != inputBox {
+		slog.Warn("inputter: ignoring cache of another input box", "path", cache.path,
+			"inputBox", stored.InputBox)
+		return cache, nil
+	}
+	cache.NextBlock = stored.NextBlock
+	cache.LastHash = stored.LastHash
+	cache.Inputs = stored.Inputs
+	return cache, nil
+}
+
+// Add the input to the cache; skip the inputs that are already in the cache.
+// Return false if there is a gap between the input and the cached ones.
+func (c *Cache) Add(input CachedInput) bool {
+	if input.Index < uint64(len(c.Inputs)) {
+		return true
+	}
+	if input.Index > uint64(len(c.Inputs)) {
+		return false
+	}
+	c.Inputs = append(c.Inputs, input)
+	return true
+}
+
+// Set the next block to fetch and the hash of the last fetched block, and write the cache to
+// the file. If the cache has no file, only keep it in memory.
+func (c *Cache) Save(nextBlock uint64, lastHash common.Hash) error {
+	c.NextBlock = nextBlock
+	c.LastHash = lastHash
+	if c.path == "" {
+		return nil
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("inputter: failed to encode cache: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), cacheDirPermissions); err != nil {
+		return fmt.Errorf("inputter: failed to create cache dir: %w", err)
+	}
+	// write to a temporary file first, so a crash doesn't corrupt the cache
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, cacheFilePermissions); err != nil {
+		return fmt.Errorf("inputter: failed to write cache: %w", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("inputter: failed to write cache: %w", err)
+	}
+	return nil
+}
+
+// Remove the inputs after the first count ones and fetch the events again from the block.
+func (c *Cache) Rewind(count int, nextBlock uint64) {
+	c.Inputs = c.Inputs[:count]
+	c.NextBlock = nextBlock
+	c.LastHash = common.Hash{}
+}
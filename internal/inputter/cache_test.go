@@ -0,0 +1,62 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package inputter
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	dir := t.TempDir()
+	chainID := big.NewInt(11155111)
+	application := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	inputBox := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+
+	cache, err := LoadCache(dir, chainID, application, inputBox)
+	require.Nil(t, err)
+	assert.Empty(t, cache.Inputs)
+	assert.Equal(t, uint64(0), cache.NextBlock)
+
+	first := CachedInput{Index: 0, Payload: []byte("first"), BlockNumber: 10, Timestamp: 100}
+	assert.True(t, cache.Add(first))
+	assert.True(t, cache.Add(first))
+	assert.False(t, cache.Add(CachedInput{Index: 2}))
+	require.Nil(t, cache.Save(20, common.Hash{1}))
+
+	cache, err = LoadCache(dir, chainID, application, inputBox)
+	require.Nil(t, err)
+	assert.Equal(t, []CachedInput{first}, cache.Inputs)
+	assert.Equal(t, uint64(20), cache.NextBlock)
+	assert.Equal(t, common.Hash{1}, cache.LastHash)
+
+	cache.Rewind(0, 10)
+	assert.Empty(t, cache.Inputs)
+	assert.Equal(t, uint64(10), cache.NextBlock)
+	assert.Equal(t, common.Hash{}, cache.LastHash)
+
+	// other chains, applications, and input boxes don't share the cache
+	cache, err = LoadCache(dir, big.NewInt(1), application, inputBox)
+	require.Nil(t, err)
+	assert.Empty(t, cache.Inputs)
+	cache, err = LoadCache(dir, chainID, common.Address{}, inputBox)
+	require.Nil(t, err)
+	assert.Empty(t, cache.Inputs)
+	cache, err = LoadCache(dir, chainID, application, common.Address{})
+	require.Nil(t, err)
+	assert.Empty(t, cache.Inputs)
+
+	// invalid files are ignored
+	path := filepath.Join(dir, "11155111-0x00000000000000000000000000000000000000aa.json")
+	require.Nil(t, os.WriteFile(path, []byte("invalid"), cacheFilePermissions))
+	cache, err = LoadCache(dir, chainID, application, inputBox)
+	require.Nil(t, err)
+	assert.Empty(t, cache.Inputs)
+}
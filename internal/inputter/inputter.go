@@ -5,16 +5,59 @@ package inputter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"math/big"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/gligneul/nonodo/internal/contracts"
+	"github.com/gligneul/nonodo/internal/model"
 )
 
+// Default interval between checks for new blocks.
+const DefaultPollInterval = 500 * time.Millisecond
+
+// Delay before the first reconnection to the provider.
+// If the inputter reads the new blocks after reconnecting, the delay goes back to this value.
+const MinReconnectBackoff = time.Second
+
+// Maximum delay between reconnections.
+const MaxReconnectBackoff = 30 * time.Second
+
+// Factor that multiplies the delay after each reconnection.
+const reconnectBackoffFactor = 2
+
+// Default number of blocks in the first eth_getLogs call.
+const DefaultLogChunkSize = 10_000
+
+// Maximum number of blocks in each eth_getLogs call.
+const MaxLogChunkSize = 100_000
+
+// Factor that multiplies or divides the number of blocks in each eth_getLogs call.
+const logChunkFactor = 2
+
+// JSON-RPC error code some providers return when an eth_getLogs call has too many results.
+const limitExceededErrorCode = -32005
+
+// Parts of the messages providers return when an eth_getLogs call has too many blocks or
+// results, in lower case.
+var logLimitMessages = []string{"range", "too large", "more than", "response size"}
+
+// Parts of the messages providers return when rate limiting the calls, in lower case.
+// Smaller ranges don't help in this case, so the inputter backs off instead.
+var rateLimitMessages = []string{"rate limit", "too many requests"}
+
+// The inputter found an InputBox event with an index after the expected one.
+var ErrMissedInput = errors.New("missed input")
+
 type Model interface {
 	AddAdvanceInput(
 		sender common.Address,
@@ -22,15 +65,44 @@ type Model interface {
 		blockNumber uint64,
 		timestamp time.Time,
 	)
+	AddPendingInput(input model.PendingInput)
+	ReleasePendingInputs(now time.Time, block uint64) int
+	GetNumInputBoxInputs() int
+	RollbackInputBoxInputs(count int) int
 }
 
 // This worker reads inputs from Ethereum and puts them in the model.
+// The worker polls the provider for the events in the new blocks, so it works with HTTP and
+// websocket providers. When the provider fails, the worker reconnects and continues from the
+// first block it didn't read.
+// When the hash of the last read block changes, the worker finds the inputs orphaned by the
+// reorg, rolls them back, and reads the events again from the last block it still has.
 type InputterWorker struct {
 	Model              Model
 	Provider           string
 	InputBoxAddress    common.Address
 	InputBoxBlock      uint64
 	ApplicationAddress common.Address
+
+	// If set, persist the fetched inputs in this directory and resume from the last fetched
+	// block after restarting.
+	CacheDir string
+
+	// Interval between checks for new blocks; zero means DefaultPollInterval.
+	PollInterval time.Duration
+
+	// Number of blocks in the first eth_getLogs call; zero means DefaultLogChunkSize.
+	LogChunkSize uint64
+
+	// Number of blocks after the latest one that the worker waits before reading its inputs.
+	ConfirmationDepth uint64
+
+	// If set, called to roll back the InputBox inputs after the first count ones.
+	// Otherwise, the worker rolls back the inputs in the model directly.
+	Rollback func(count int)
+
+	// Delay of the new inputs, which are pending in the model until they are released.
+	Latency Latency
 }
 
 func (w InputterWorker) String() string {
@@ -38,108 +110,415 @@ func (w InputterWorker) String() string {
 }
 
 func (w InputterWorker) Start(ctx context.Context, ready chan<- struct{}) error {
+	ready <- struct{}{}
+	// the cache keeps the progress of the inputter between reconnections
+	var cache *Cache
+	backoff := MinReconnectBackoff
+	for {
+		err := w.poll(ctx, &cache, func() { backoff = MinReconnectBackoff })
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if errors.Is(err, ErrMissedInput) {
+			return err
+		}
+		slog.Warn("inputter: reconnecting", "error", err, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*reconnectBackoffFactor, MaxReconnectBackoff)
+	}
+}
+
+// Connect to the provider and read the events of the new blocks until there is an error.
+// Call the onProgress function after reading each block range.
+func (w InputterWorker) poll(ctx context.Context, cache **Cache, onProgress func()) error {
 	client, err := ethclient.DialContext(ctx, w.Provider)
 	if err != nil {
 		return fmt.Errorf("inputter: dial: %w", err)
 	}
+	defer client.Close()
 	inputBox, err := contracts.NewInputBox(w.InputBoxAddress, client)
 	if err != nil {
 		return fmt.Errorf("inputter: bind input box: %w", err)
 	}
-	ready <- struct{}{}
+	if *cache == nil {
+		*cache, err = w.loadCache(ctx, client)
+		if err != nil {
+			return err
+		}
+	}
+	interval := w.PollInterval
+	if interval == 0 {
+		interval = DefaultPollInterval
+	}
+	chunk := logChunk{size: w.LogChunkSize, ceiling: MaxLogChunkSize}
+	if chunk.size == 0 {
+		chunk.size = DefaultLogChunkSize
+	}
+	for {
+		if err := w.checkReorg(ctx, client, *cache); err != nil {
+			return err
+		}
+		latest, err := client.BlockNumber(ctx)
+		if err != nil {
+			return fmt.Errorf("inputter: failed to get block number: %w", err)
+		}
+		if latest >= w.ConfirmationDepth && latest-w.ConfirmationDepth >= (*cache).NextBlock {
+			end := latest - w.ConfirmationDepth
+			err := w.readInputs(ctx, client, inputBox, *cache, end, &chunk)
+			if err != nil {
+				return err
+			}
+		}
+		if w.Latency.enabled() {
+			w.Model.ReleasePendingInputs(time.Now(), latest)
+		}
+		onProgress()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
 
-	// First, read the event logs to get the past inputs; then, watch the event logs to get the
-	// new ones. There is a race condition where we might lose inputs sent between the
-	// readPastInputs call and the watchNewInputs call. Given that nonodo is a development node,
-	// we accept this race condition.
-	err = w.readPastInputs(ctx, client, inputBox)
-	if err != nil {
-		return err
+// Load the cache and add the cached inputs to the model.
+// If the cache directory is not set, return an empty cache that is kept in memory.
+func (w InputterWorker) loadCache(ctx context.Context, client *ethclient.Client) (*Cache, error) {
+	cache := &Cache{InputBox: w.InputBoxAddress}
+	if w.CacheDir != "" {
+		chainID, err := client.ChainID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("inputter: failed to get chain id: %w", err)
+		}
+		cache, err = LoadCache(w.CacheDir, chainID, w.ApplicationAddress, w.InputBoxAddress)
+		if err != nil {
+			return nil, err
+		}
+		slog.Info("inputter: loaded cache", "path", cache.path, "inputs", len(cache.Inputs),
+			"nextBlock", cache.NextBlock)
+		for _, input := range cache.Inputs {
+			if err := w.addToModel(input, Latency{}); err != nil {
+				return nil, err
+			}
+		}
 	}
-	return w.watchNewInputs(ctx, client, inputBox)
+	if cache.NextBlock < w.InputBoxBlock {
+		cache.NextBlock = w.InputBoxBlock
+	}
+	return cache, nil
 }
 
-// Read inputs starting from the input box deployment block until the latest block.
-func (w InputterWorker) readPastInputs(
+// Read the inputs from the next block until the end block in chunks, and move the cursor after
+// each chunk.
+func (w InputterWorker) readInputs(
 	ctx context.Context,
 	client *ethclient.Client,
 	inputBox *contracts.InputBox,
+	cache *Cache,
+	end uint64,
+	chunk *logChunk,
 ) error {
+	start := cache.NextBlock
+	total := end - start + 1
+	// only report the progress when reading the history
+	verbose := total > chunk.size
+	for cache.NextBlock <= end {
+		chunkEnd := min(cache.NextBlock+chunk.size-1, end)
+		// get the header before the logs, so the logs can't be newer than the saved hash
+		header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(chunkEnd))
+		if err != nil {
+			return fmt.Errorf("inputter: failed to get block header: %w", err)
+		}
+		events, err := w.filterInputs(ctx, inputBox, cache.NextBlock, chunkEnd)
+		if err != nil {
+			// other errors go to the reconnection, so they don't lower the ceiling for good
+			if chunk.size == 1 || !isLogLimitError(err) {
+				return err
+			}
+			chunk.shrink()
+			slog.Warn("inputter: shrinking block range", "error", err, "chunkSize", chunk.size)
+			continue
+		}
+		// if the chain changed while reading the logs, they might come from either fork, so
+		// drop them and let the next poll check for the reorg
+		current, err := client.HeaderByNumber(ctx, header.Number)
+		if err != nil {
+			return fmt.Errorf("inputter: failed to get block header: %w", err)
+		}
+		if current.Hash() != header.Hash() {
+			slog.Warn("inputter: chain changed while reading the logs", "block", chunkEnd)
+			return nil
+		}
+		if err := w.addInputs(ctx, client, events, cache); err != nil {
+			return err
+		}
+		if err := cache.Save(chunkEnd+1, header.Hash()); err != nil {
+			return err
+		}
+		chunk.grow()
+		if verbose {
+			const percent = 100
+			scanned := chunkEnd - start + 1
+			slog.Info("inputter: scanned blocks",
+				"progress", fmt.Sprintf("%.1f%%", float64(scanned)*percent/float64(total)),
+				"block", chunkEnd, "end", end, "inputs", len(cache.Inputs))
+		}
+	}
+	return nil
+}
+
+// Number of blocks in each eth_getLogs call, which adapts to the limits of the provider.
+type logChunk struct {
+	size uint64
+
+	// Maximum size, which is below the sizes that failed.
+	ceiling uint64
+}
+
+// Halve the size after it failed, and keep the next sizes below it.
+func (c *logChunk) shrink() {
+	c.ceiling = c.size - 1
+	c.size = max(c.size/logChunkFactor, 1)
+}
+
+// Grow the size after it succeeded, approaching the ceiling by halving the distance to it.
+func (c *logChunk) grow() {
+	c.size = min(c.size*logChunkFactor, (c.size+c.ceiling+1)/logChunkFactor)
+}
+
+// Check whether the provider rejected an eth_getLogs call because it had too many blocks or
+// results.
+func isLogLimitError(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, part := range rateLimitMessages {
+		if strings.Contains(message, part) {
+			return false
+		}
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == limitExceededErrorCode {
+		return true
+	}
+	for _, part := range logLimitMessages {
+		if strings.Contains(message, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the InputAdded events of the application between the start and end blocks.
+func (w InputterWorker) filterInputs(
+	ctx context.Context,
+	inputBox *contracts.InputBox,
+	start uint64,
+	end uint64,
+) ([]*contracts.InputBoxInputAdded, error) {
 	opts := bind.FilterOpts{
 		Context: ctx,
-		Start:   w.InputBoxBlock,
+		Start:   start,
+		End:     &end,
 	}
 	filter := []common.Address{w.ApplicationAddress}
 	it, err := inputBox.FilterInputAdded(&opts, filter, nil)
 	if err != nil {
-		return fmt.Errorf("inputter: filter input added: %v", err)
+		return nil, fmt.Errorf("inputter: filter input added: %w", err)
 	}
 	defer it.Close()
+	var events []*contracts.InputBoxInputAdded
 	for it.Next() {
-		if err := w.addInput(ctx, client, it.Event); err != nil {
+		events = append(events, it.Event)
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("inputter: filter input added: %w", err)
+	}
+	return events, nil
+}
+
+// Check whether the last read block was reorged.
+// If so, roll back the orphaned inputs and rewind the cursor to the last block with an input
+// that is still in the chain.
+func (w InputterWorker) checkReorg(
+	ctx context.Context,
+	client *ethclient.Client,
+	cache *Cache,
+) error {
+	if cache.LastHash == (common.Hash{}) {
+		return nil
+	}
+	same, err := w.sameBlock(ctx, client, cache.NextBlock-1, cache.LastHash)
+	if err != nil || same {
+		return err
+	}
+	count := len(cache.Inputs)
+	for ; count > 0; count-- {
+		input := cache.Inputs[count-1]
+		same, err := w.sameBlock(ctx, client, input.BlockNumber, input.BlockHash)
+		if err != nil {
 			return err
 		}
+		if same {
+			break
+		}
+	}
+	nextBlock := w.InputBoxBlock
+	if count > 0 {
+		nextBlock = cache.Inputs[count-1].BlockNumber + 1
+	}
+	slog.Warn("inputter: reorg detected", "block", cache.NextBlock-1,
+		"orphaned", len(cache.Inputs)-count, "nextBlock", nextBlock)
+	cache.Rewind(count, nextBlock)
+	if count < w.Model.GetNumInputBoxInputs() {
+		if w.Rollback != nil {
+			w.Rollback(count)
+		} else {
+			w.Model.RollbackInputBoxInputs(count)
+		}
 	}
 	return nil
 }
 
-// Watch new inputs added to the input box.
-// This function continues to run forever until there is an error or the context is canceled.
-func (w InputterWorker) watchNewInputs(
+// Check whether the block with the number in the chain has the hash.
+func (w InputterWorker) sameBlock(
 	ctx context.Context,
 	client *ethclient.Client,
-	inputBox *contracts.InputBox,
+	number uint64,
+	hash common.Hash,
+) (bool, error) {
+	header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if errors.Is(err, ethereum.NotFound) {
+		// the chain is shorter than the block
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("inputter: failed to get block header: %w", err)
+	}
+	return header.Hash() == hash, nil
+}
+
+// Add the inputs of the events to the model and to the cache.
+// Get the timestamps of the blocks of the new inputs in a single batch.
+func (w InputterWorker) addInputs(
+	ctx context.Context,
+	client *ethclient.Client,
+	events []*contracts.InputBoxInputAdded,
+	cache *Cache,
 ) error {
-	logs := make(chan *contracts.InputBoxInputAdded)
-	opts := bind.WatchOpts{
-		Context: ctx,
+	var inputs []CachedInput
+	for _, event := range events {
+		if !event.InputIndex.IsUint64() {
+			return fmt.Errorf("inputter: invalid input index: %v", event.InputIndex)
+		}
+		index := event.InputIndex.Uint64()
+		// When the worker restarts, it reads the past inputs again, so skip the ones in the
+		// model and in the cache.
+		inModel := index < uint64(w.Model.GetNumInputBoxInputs())
+		if inModel && index < uint64(len(cache.Inputs)) {
+			slog.Debug("inputter: skipping known input", "input.index", index)
+			continue
+		}
+		inputs = append(inputs, CachedInput{
+			Index:       index,
+			Sender:      event.Sender,
+			Payload:     event.Input,
+			BlockNumber: event.Raw.BlockNumber,
+			BlockHash:   event.Raw.BlockHash,
+		})
 	}
-	filter := []common.Address{w.ApplicationAddress}
-	sub, err := inputBox.WatchInputAdded(&opts, logs, filter, nil)
+	timestamps, err := getTimestamps(ctx, client, inputs)
 	if err != nil {
-		return fmt.Errorf("inputter: watch input added: %w", err)
+		return err
 	}
-	defer sub.Unsubscribe()
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case err := <-sub.Err():
+	for _, input := range inputs {
+		input.Timestamp = timestamps[input.BlockHash]
+		slog.Debug("inputter: read event",
+			"dapp", w.ApplicationAddress,
+			"input.index", input.Index,
+			"sender", input.Sender,
+			"input", input.Payload,
+			slog.Group("block",
+				"number", input.BlockNumber,
+				"timestamp", time.Unix(int64(input.Timestamp), 0),
+			),
+		)
+		if !cache.Add(input) {
+			slog.Warn("inputter: input missing in cache", "input.index", input.Index,
+				"cached", len(cache.Inputs))
+		}
+		if err := w.addToModel(input, w.Latency); err != nil {
 			return err
-		case event := <-logs:
-			if err := w.addInput(ctx, client, event); err != nil {
-				return err
-			}
 		}
 	}
+	return nil
 }
 
-// Add the input to the model.
-func (w InputterWorker) addInput(
+// Get the timestamps of the blocks of the inputs, indexed by block hash.
+// Get each block header once, in a single batch request.
+func getTimestamps(
 	ctx context.Context,
 	client *ethclient.Client,
-	event *contracts.InputBoxInputAdded,
-) error {
-	header, err := client.HeaderByHash(ctx, event.Raw.BlockHash)
-	if err != nil {
-		return fmt.Errorf("inputter: failed to get tx header: %w", err)
-	}
-	timestamp := time.Unix(int64(header.Time), 0)
-	slog.Debug("inputter: read event",
-		"dapp", event.Dapp,
-		"input.index", event.InputIndex,
-		"sender", event.Sender,
-		"input", event.Input,
-		slog.Group("block",
-			"number", header.Number,
-			"timestamp", timestamp,
-		),
-	)
+	inputs []CachedInput,
+) (map[common.Hash]uint64, error) {
+	timestamps := make(map[common.Hash]uint64)
+	var hashes []common.Hash
+	for _, input := range inputs {
+		if _, ok := timestamps[input.BlockHash]; !ok {
+			timestamps[input.BlockHash] = 0
+			hashes = append(hashes, input.BlockHash)
+		}
+	}
+	if len(hashes) == 0 {
+		return timestamps, nil
+	}
+	headers := make([]*types.Header, len(hashes))
+	batch := make([]rpc.BatchElem, len(hashes))
+	for i, hash := range hashes {
+		batch[i] = rpc.BatchElem{
+			Method: "eth_getBlockByHash",
+			Args:   []any{hash, false},
+			Result: &headers[i],
+		}
+	}
+	if err := client.Client().BatchCallContext(ctx, batch); err != nil {
+		return nil, fmt.Errorf("inputter: failed to get tx headers: %w", err)
+	}
+	for i, hash := range hashes {
+		if batch[i].Error != nil {
+			return nil, fmt.Errorf("inputter: failed to get tx header: %w", batch[i].Error)
+		}
+		if headers[i] == nil {
+			return nil, fmt.Errorf("inputter: failed to get tx header: %w: %v",
+				ethereum.NotFound, hash)
+		}
+		timestamps[hash] = headers[i].Time
+	}
+	return timestamps, nil
+}
+
+// Add the input to the model if it isn't there yet; hold it as pending if there is latency.
+// Return an error if there is a gap between the input and the ones in the model.
+func (w InputterWorker) addToModel(input CachedInput, latency Latency) error {
+	expected := uint64(w.Model.GetNumInputBoxInputs())
+	if input.Index < expected {
+		return nil
+	}
+	if input.Index > expected {
+		return fmt.Errorf("inputter: %w: expected index %v; got %v", ErrMissedInput, expected,
+			input.Index)
+	}
+	if latency.enabled() {
+		w.Model.AddPendingInput(latency.pending(input))
+		return nil
+	}
 	w.Model.AddAdvanceInput(
-		event.Sender,
-		event.Input,
-		event.Raw.BlockNumber,
-		timestamp,
+		input.Sender,
+		input.Payload,
+		input.BlockNumber,
+		time.Unix(int64(input.Timestamp), 0),
 	)
 	return nil
 }
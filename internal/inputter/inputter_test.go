@@ -0,0 +1,559 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package inputter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gligneul/nonodo/internal/contracts"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testApplication = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	testInputBox    = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	testSender      = common.HexToAddress("0x00000000000000000000000000000000000000cc")
+)
+
+const testPollInterval = 10 * time.Millisecond
+
+// Fake Ethereum node with the InputBox events, served over HTTP.
+type fakeEth struct {
+	t        *testing.T
+	mutex    sync.Mutex
+	headers  []*types.Header
+	orphans  []*types.Header
+	logs     []types.Log
+	failures int
+
+	// Number of reorgs, which makes the hashes of the new blocks differ from the old ones.
+	forks byte
+
+	// If set, eth_getLogs fails when the range has more blocks than this.
+	maxRange uint64
+
+	// Start block of each eth_getLogs call.
+	ranges []uint64
+
+	// If set, the next eth_getLogs call replaces this number of blocks after reading the logs.
+	reorgOnLogs int
+}
+
+// Filter of eth_getLogs; the fake ignores the address and the topics.
+type fakeFilter struct {
+	FromBlock string `json:"fromBlock"`
+	ToBlock   string `json:"toBlock"`
+	Address   any    `json:"address"`
+	Topics    any    `json:"topics"`
+}
+
+func newFakeEth(t *testing.T) *fakeEth {
+	f := &fakeEth{t: t}
+	f.mine()
+	return f
+}
+
+// Add a new block and return its header; must be called with the lock.
+func (f *fakeEth) mine() *types.Header {
+	number := uint64(len(f.headers))
+	header := &types.Header{
+		Number:     new(big.Int).SetUint64(number),
+		Difficulty: big.NewInt(0),
+		Time:       1000 + number,
+		Extra:      []byte{f.forks},
+	}
+	if number > 0 {
+		header.ParentHash = f.headers[number-1].Hash()
+	}
+	f.headers = append(f.headers, header)
+	return header
+}
+
+// Add an InputAdded event in a new block.
+func (f *fakeEth) addInput(index int64, payload string) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	abi, err := contracts.InputBoxMetaData.GetAbi()
+	require.Nil(f.t, err)
+	event := abi.Events["InputAdded"]
+	data, err := event.Inputs.NonIndexed().Pack(testSender, []byte(payload))
+	require.Nil(f.t, err)
+	header := f.mine()
+	f.logs = append(f.logs, types.Log{
+		Address: testInputBox,
+		Topics: []common.Hash{
+			event.ID,
+			common.BytesToHash(testApplication.Bytes()),
+			common.BigToHash(big.NewInt(index)),
+		},
+		Data:        data,
+		BlockNumber: header.Number.Uint64(),
+		BlockHash:   header.Hash(),
+	})
+}
+
+// Add empty blocks.
+func (f *fakeEth) addBlocks(count int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for i := 0; i < count; i++ {
+		f.mine()
+	}
+}
+
+// Remove the last blocks and their events.
+func (f *fakeEth) reorg(blocks int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.fork(blocks)
+}
+
+// Remove the last blocks and their events; must be called with the lock.
+func (f *fakeEth) fork(blocks int) {
+	f.forks++
+	// like real nodes, keep serving the orphaned blocks by hash
+	f.orphans = append(f.orphans, f.headers[len(f.headers)-blocks:]...)
+	f.headers = f.headers[:len(f.headers)-blocks]
+	latest := uint64(len(f.headers) - 1)
+	logs := []types.Log{}
+	for _, log := range f.logs {
+		if log.BlockNumber <= latest {
+			logs = append(logs, log)
+		}
+	}
+	f.logs = logs
+}
+
+// Make the next calls fail.
+func (f *fakeEth) fail(calls int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.failures = calls
+}
+
+func (f *fakeEth) check() error {
+	if f.failures > 0 {
+		f.failures--
+		return errors.New("injected failure")
+	}
+	return nil
+}
+
+func (f *fakeEth) ChainId() (*hexutil.Big, error) {
+	return (*hexutil.Big)(big.NewInt(1)), nil
+}
+
+func (f *fakeEth) BlockNumber() (hexutil.Uint64, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return hexutil.Uint64(len(f.headers) - 1), f.check()
+}
+
+func (f *fakeEth) GetLogs(filter fakeFilter) ([]types.Log, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if err := f.check(); err != nil {
+		return nil, err
+	}
+	from, err := hexutil.DecodeUint64(filter.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+	to, err := hexutil.DecodeUint64(filter.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+	if f.maxRange != 0 && to-from+1 > f.maxRange {
+		return nil, errors.New("range too large")
+	}
+	f.ranges = append(f.ranges, from)
+	logs := []types.Log{}
+	for _, log := range f.logs {
+		if log.BlockNumber >= from && log.BlockNumber <= to {
+			logs = append(logs, log)
+		}
+	}
+	if f.reorgOnLogs > 0 {
+		blocks := f.reorgOnLogs
+		f.reorgOnLogs = 0
+		f.fork(blocks)
+		for i := 0; i < blocks; i++ {
+			f.mine()
+		}
+	}
+	return logs, nil
+}
+
+func (f *fakeEth) GetBlockByHash(hash common.Hash, full bool) (*types.Header, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, headers := range [][]*types.Header{f.headers, f.orphans} {
+		for _, header := range headers {
+			if header.Hash() == hash {
+				return header, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeEth) GetBlockByNumber(number string, full bool) (*types.Header, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	n, err := hexutil.DecodeUint64(number)
+	if err != nil {
+		return nil, err
+	}
+	if n >= uint64(len(f.headers)) {
+		return nil, nil
+	}
+	return f.headers[n], nil
+}
+
+// Start the fake node and return its URL.
+func startFakeEth(t *testing.T, f *fakeEth) string {
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("eth", f))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	t.Cleanup(server.Stop)
+	return httpServer.URL
+}
+
+// Start the inputter and return the channel with its result.
+func startInputter(ctx context.Context, w InputterWorker) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- w.Start(ctx, make(chan struct{}, 1))
+	}()
+	return result
+}
+
+func TestInputterWorker(t *testing.T) {
+	f := newFakeEth(t)
+	f.addInput(0, "first")
+	f.addInput(1, "second")
+	m := model.NewNonodoModel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result := startInputter(ctx, InputterWorker{
+		Model:              m,
+		Provider:           startFakeEth(t, f),
+		InputBoxAddress:    testInputBox,
+		ApplicationAddress: testApplication,
+		CacheDir:           t.TempDir(),
+		PollInterval:       testPollInterval,
+	})
+	require.Eventually(t, func() bool {
+		return m.GetNumInputs(model.InputFilter{}) == 2
+	}, 5*time.Second, testPollInterval)
+
+	// the inputter reconnects after the failure and doesn't count the sequenced inputs
+	m.AddSequencedInput(testSender, []byte("sequenced"), time.Now())
+	f.fail(1)
+	f.addInput(2, "third")
+	require.Eventually(t, func() bool {
+		return m.GetNumInputs(model.InputFilter{}) == 4
+	}, 5*time.Second, testPollInterval)
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+
+	inputs := m.GetInputs(model.InputFilter{}, 0, 100)
+	require.Len(t, inputs, 4)
+	assert.Equal(t, []byte("first"), inputs[0].Payload)
+	assert.Equal(t, []byte("second"), inputs[1].Payload)
+	assert.Equal(t, []byte("third"), inputs[3].Payload)
+	assert.Equal(t, testSender, inputs[3].MsgSender)
+	assert.Equal(t, uint64(3), inputs[3].BlockNumber)
+	assert.Equal(t, int64(1003), inputs[3].Timestamp.Unix())
+}
+
+func TestInputterWorkerResumesFromCache(t *testing.T) {
+	f := newFakeEth(t)
+	f.addInput(0, "first")
+	f.addInput(1, "second")
+	url := startFakeEth(t, f)
+	cacheDir := t.TempDir()
+	run := func(m *model.NonodoModel, count int) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		result := startInputter(ctx, InputterWorker{
+			Model:              m,
+			Provider:           url,
+			InputBoxAddress:    testInputBox,
+			ApplicationAddress: testApplication,
+			CacheDir:           cacheDir,
+			PollInterval:       testPollInterval,
+		})
+		require.Eventually(t, func() bool {
+			return m.GetNumInputs(model.InputFilter{}) == count
+		}, 5*time.Second, testPollInterval)
+		cancel()
+		<-result
+	}
+	run(model.NewNonodoModel(), 2)
+	f.ranges = nil
+
+	// the second run loads the inputs from the cache and only fetches the new blocks
+	f.addInput(2, "third")
+	m := model.NewNonodoModel()
+	run(m, 3)
+	require.NotEmpty(t, f.ranges)
+	assert.Equal(t, uint64(3), f.ranges[0])
+	inputs := m.GetInputs(model.InputFilter{}, 0, 100)
+	require.Len(t, inputs, 3)
+	assert.Equal(t, []byte("second"), inputs[1].Payload)
+	assert.Equal(t, int64(1002), inputs[1].Timestamp.Unix())
+	assert.Equal(t, []byte("third"), inputs[2].Payload)
+}
+
+func TestInputterWorkerDetectsMissedInputs(t *testing.T) {
+	f := newFakeEth(t)
+	f.addInput(0, "first")
+	f.addInput(2, "third")
+	m := model.NewNonodoModel()
+	result := startInputter(context.Background(), InputterWorker{
+		Model:              m,
+		Provider:           startFakeEth(t, f),
+		InputBoxAddress:    testInputBox,
+		ApplicationAddress: testApplication,
+		PollInterval:       testPollInterval,
+	})
+	select {
+	case err := <-result:
+		assert.ErrorIs(t, err, ErrMissedInput)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out")
+	}
+	assert.Equal(t, 1, m.GetNumInputs(model.InputFilter{}))
+}
+
+func TestInputterWorkerRollsBackReorgedInputs(t *testing.T) {
+	f := newFakeEth(t)
+	f.addInput(0, "first")
+	f.addInput(1, "second")
+	f.addInput(2, "third")
+	m := model.NewNonodoModel()
+	var rollbacks []int
+	var mutex sync.Mutex
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result := startInputter(ctx, InputterWorker{
+		Model:              m,
+		Provider:           startFakeEth(t, f),
+		InputBoxAddress:    testInputBox,
+		ApplicationAddress: testApplication,
+		PollInterval:       testPollInterval,
+		Rollback: func(count int) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			rollbacks = append(rollbacks, count)
+			m.RollbackInputBoxInputs(count)
+		},
+	})
+	require.Eventually(t, func() bool {
+		return m.GetNumInputs(model.InputFilter{}) == 3
+	}, 5*time.Second, testPollInterval)
+
+	// the last two inputs are orphaned and the new chain has another input in their place
+	f.reorg(2)
+	f.addInput(1, "other")
+	f.addBlocks(1)
+	require.Eventually(t, func() bool {
+		inputs := m.GetInputs(model.InputFilter{}, 0, 100)
+		return len(inputs) == 2 && string(inputs[1].Payload) == "other"
+	}, 5*time.Second, testPollInterval)
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, []int{1}, rollbacks)
+}
+
+func TestInputterWorkerDropsLogsReadDuringReorg(t *testing.T) {
+	f := newFakeEth(t)
+	f.addInput(0, "first")
+	f.addInput(1, "second")
+	f.addInput(2, "third")
+	f.reorgOnLogs = 2
+	m := model.NewNonodoModel()
+	var rollbacks []int
+	var mutex sync.Mutex
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result := startInputter(ctx, InputterWorker{
+		Model:              m,
+		Provider:           startFakeEth(t, f),
+		InputBoxAddress:    testInputBox,
+		ApplicationAddress: testApplication,
+		PollInterval:       testPollInterval,
+		Rollback: func(count int) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			rollbacks = append(rollbacks, count)
+			m.RollbackInputBoxInputs(count)
+		},
+	})
+
+	// the first read returns the orphaned inputs, which never reach the model
+	require.Eventually(t, func() bool {
+		return m.GetNumInputs(model.InputFilter{}) == 1
+	}, 5*time.Second, testPollInterval)
+	time.Sleep(10 * testPollInterval)
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+	assert.Equal(t, 1, m.GetNumInputs(model.InputFilter{}))
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Empty(t, rollbacks)
+}
+
+func TestInputterWorkerWaitsForConfirmations(t *testing.T) {
+	f := newFakeEth(t)
+	f.addInput(0, "first")
+	f.addInput(1, "second")
+	m := model.NewNonodoModel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result := startInputter(ctx, InputterWorker{
+		Model:              m,
+		Provider:           startFakeEth(t, f),
+		InputBoxAddress:    testInputBox,
+		ApplicationAddress: testApplication,
+		PollInterval:       testPollInterval,
+		ConfirmationDepth:  1,
+	})
+	require.Eventually(t, func() bool {
+		return m.GetNumInputs(model.InputFilter{}) == 1
+	}, 5*time.Second, testPollInterval)
+	time.Sleep(10 * testPollInterval)
+	assert.Equal(t, 1, m.GetNumInputs(model.InputFilter{}))
+
+	f.addBlocks(1)
+	require.Eventually(t, func() bool {
+		return m.GetNumInputs(model.InputFilter{}) == 2
+	}, 5*time.Second, testPollInterval)
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+}
+
+func TestInputterWorkerReadsHistoryInChunks(t *testing.T) {
+	f := newFakeEth(t)
+	f.maxRange = 10
+	for i := 0; i < 5; i++ {
+		f.addBlocks(20)
+		f.addInput(int64(i), fmt.Sprint(i))
+	}
+	m := model.NewNonodoModel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result := startInputter(ctx, InputterWorker{
+		Model:              m,
+		Provider:           startFakeEth(t, f),
+		InputBoxAddress:    testInputBox,
+		ApplicationAddress: testApplication,
+		PollInterval:       testPollInterval,
+		LogChunkSize:       64,
+	})
+	require.Eventually(t, func() bool {
+		return m.GetNumInputs(model.InputFilter{}) == 5
+	}, 5*time.Second, testPollInterval)
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+
+	inputs := m.GetInputs(model.InputFilter{}, 0, 100)
+	for i, input := range inputs {
+		assert.Equal(t, []byte(fmt.Sprint(i)), input.Payload)
+		assert.Equal(t, uint64(21*(i+1)), input.BlockNumber)
+		assert.Equal(t, int64(1000+21*(i+1)), input.Timestamp.Unix())
+	}
+	// the inputter scanned the blocks in order, without gaps
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	require.NotEmpty(t, f.ranges)
+	assert.Equal(t, uint64(0), f.ranges[0])
+	for i := 1; i < len(f.ranges); i++ {
+		assert.Greater(t, f.ranges[i], f.ranges[i-1])
+		assert.LessOrEqual(t, f.ranges[i]-f.ranges[i-1], f.maxRange)
+	}
+}
+
+func TestIsLogLimitError(t *testing.T) {
+	assert.True(t, isLogLimitError(rpcError{code: -32005, message: "query timeout exceeded"}))
+	assert.True(t, isLogLimitError(rpcError{code: -32602,
+		message: "Log response size exceeded. You can make eth_getLogs requests with up to a " +
+			"2K block range and no limit on the response size"}))
+	assert.True(t, isLogLimitError(fmt.Errorf("inputter: filter input added: %w",
+		rpcError{code: -32000, message: "block range is too wide"})))
+	assert.False(t, isLogLimitError(rpcError{code: -32000, message: "header not found"}))
+	assert.False(t, isLogLimitError(rpcError{code: -32005,
+		message: "daily request count exceeded, request rate limited"}))
+	assert.False(t, isLogLimitError(errors.New("429 Too Many Requests")))
+	assert.False(t, isLogLimitError(errors.New("connection refused")))
+	assert.False(t, isLogLimitError(context.DeadlineExceeded))
+}
+
+// Error of a JSON-RPC response.
+type rpcError struct {
+	code    int
+	message string
+}
+
+func (e rpcError) Error() string {
+	return e.message
+}
+
+func (e rpcError) ErrorCode() int {
+	return e.code
+}
+
+func TestInputterWorkerHoldsInputsWithLatency(t *testing.T) {
+	f := newFakeEth(t)
+	f.addInput(0, "first")
+	m := model.NewNonodoModel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result := startInputter(ctx, InputterWorker{
+		Model:              m,
+		Provider:           startFakeEth(t, f),
+		InputBoxAddress:    testInputBox,
+		ApplicationAddress: testApplication,
+		PollInterval:       testPollInterval,
+		Latency:            Latency{Blocks: 2},
+	})
+	require.Eventually(t, func() bool {
+		return len(m.GetPendingInputs()) == 1
+	}, 5*time.Second, testPollInterval)
+	f.addInput(1, "second")
+	require.Eventually(t, func() bool {
+		return len(m.GetPendingInputs()) == 2
+	}, 5*time.Second, testPollInterval)
+	assert.Equal(t, 0, m.GetNumInputs(model.InputFilter{}))
+
+	// the first input is released after two blocks, and the second one after three blocks
+	f.addBlocks(1)
+	require.Eventually(t, func() bool {
+		return m.GetNumInputs(model.InputFilter{}) == 1
+	}, 5*time.Second, testPollInterval)
+	f.addBlocks(1)
+	require.Eventually(t, func() bool {
+		return m.GetNumInputs(model.InputFilter{}) == 2
+	}, 5*time.Second, testPollInterval)
+	assert.Empty(t, m.GetPendingInputs())
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package inputter
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gligneul/nonodo/internal/model"
+)
+
+// Suffix of the latency given in number of blocks.
+const latencyBlocksSuffix = "blocks"
+
+// Delay of the new inputs before they reach the model, emulating the time an input takes to
+// arrive in a test net. The zero value means no delay.
+type Latency struct {
+	// Range of the delay after the timestamp of the input block.
+	// The delay is uniformly distributed in the range.
+	Min time.Duration
+	Max time.Duration
+
+	// Number of blocks the chain must advance after the input block.
+	Blocks uint64
+}
+
+// Parse the latency from a string, which is a duration like 12s, a range of durations like
+// 5s-30s, or a number of blocks like 3blocks.
+func ParseLatency(spec string) (Latency, error) {
+	spec = strings.TrimSpace(spec)
+	if value, ok := strings.CutSuffix(spec, latencyBlocksSuffix); ok {
+		blocks, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return Latency{}, fmt.Errorf("inputter: invalid latency blocks: %w", err)
+		}
+		return Latency{Blocks: blocks}, nil
+	}
+	minValue, maxValue, isRange := strings.Cut(spec, "-")
+	if !isRange {
+		maxValue = minValue
+	}
+	minDelay, err := time.ParseDuration(minValue)
+	if err != nil {
+		return Latency{}, fmt.Errorf("inputter: invalid latency: %w", err)
+	}
+	maxDelay, err := time.ParseDuration(maxValue)
+	if err != nil {
+		return Latency{}, fmt.Errorf("inputter: invalid latency: %w", err)
+	}
+	if minDelay < 0 || maxDelay < minDelay {
+		return Latency{}, fmt.Errorf("inputter: invalid latency range: %v", spec)
+	}
+	return Latency{Min: minDelay, Max: maxDelay}, nil
+}
+
+func (l Latency) String() string {
+	switch {
+	case l.Blocks != 0:
+		return fmt.Sprintf("%v%v", l.Blocks, latencyBlocksSuffix)
+	case l.Min != l.Max:
+		return fmt.Sprintf("%v-%v", l.Min, l.Max)
+	default:
+		return l.Min.String()
+	}
+}
+
+// Whether the latency delays the inputs.
+func (l Latency) enabled() bool {
+	return l.Max != 0 || l.Blocks != 0
+}
+
+// Create the pending input that is released after the latency.
+func (l Latency) pending(input CachedInput) model.PendingInput {
+	delay := l.Min
+	if l.Max > l.Min {
+		delay += time.Duration(rand.Int63n(int64(l.Max-l.Min) + 1))
+	}
+	timestamp := time.Unix(int64(input.Timestamp), 0)
+	return model.PendingInput{
+		MsgSender:    input.Sender,
+		Payload:      input.Payload,
+		BlockNumber:  input.BlockNumber,
+		Timestamp:    timestamp,
+		ReleaseAt:    timestamp.Add(delay),
+		ReleaseBlock: input.BlockNumber + l.Blocks,
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package inputter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLatency(t *testing.T) {
+	latency, err := ParseLatency("12s")
+	require.Nil(t, err)
+	assert.Equal(t, Latency{Min: 12 * time.Second, Max: 12 * time.Second}, latency)
+	assert.Equal(t, "12s", latency.String())
+
+	latency, err = ParseLatency("5s-30s")
+	require.Nil(t, err)
+	assert.Equal(t, Latency{Min: 5 * time.Second, Max: 30 * time.Second}, latency)
+	assert.Equal(t, "5s-30s", latency.String())
+
+	latency, err = ParseLatency("3blocks")
+	require.Nil(t, err)
+	assert.Equal(t, Latency{Blocks: 3}, latency)
+	assert.Equal(t, "3blocks", latency.String())
+
+	for _, spec := range []string{"", "12", "30s-5s", "-1s", "xblocks"} {
+		_, err = ParseLatency(spec)
+		assert.NotNil(t, err, spec)
+	}
+}
+
+func TestLatencyPending(t *testing.T) {
+	latency := Latency{Min: time.Second, Max: 2 * time.Second, Blocks: 3}
+	input := CachedInput{Payload: []byte("payload"), BlockNumber: 10, Timestamp: 1000}
+	for i := 0; i < 10; i++ {
+		pending := latency.pending(input)
+		assert.Equal(t, uint64(13), pending.ReleaseBlock)
+		delay := pending.ReleaseAt.Sub(time.Unix(1000, 0))
+		assert.GreaterOrEqual(t, delay, time.Second)
+		assert.LessOrEqual(t, delay, 2*time.Second)
+	}
+}
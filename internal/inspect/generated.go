@@ -40,6 +40,9 @@ type InspectResult struct {
 	// An empty payload is represented by the string '0x'.
 	ExceptionPayload Payload `json:"exception_payload"`
 
+	// Logs Lines the application wrote to stdout and stderr while processing the inspect (nonodo extension)
+	Logs *[]string `json:"logs,omitempty"`
+
 	// ProcessedInputCount Number of processed inputs since genesis
 	ProcessedInputCount int      `json:"processed_input_count"`
 	Reports             []Report `json:"reports"`
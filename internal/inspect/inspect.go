@@ -94,6 +94,8 @@ func convertInput(input model.InspectInput) InspectResult {
 		status = Rejected
 	case model.CompletionStatusException:
 		status = Exception
+	case model.CompletionStatusMachineHalted:
+		status = MachineHalted
 	default:
 		panic("invalid completion status")
 	}
@@ -105,10 +107,21 @@ func convertInput(input model.InspectInput) InspectResult {
 		})
 	}
 
+	// logs are a nonodo extension, so they are omitted when empty
+	var logs *[]string
+	if len(input.Logs) > 0 {
+		lines := make([]string, len(input.Logs))
+		for i, log := range input.Logs {
+			lines[i] = log.Line
+		}
+		logs = &lines
+	}
+
 	return InspectResult{
 		Status:              status,
 		Reports:             reports,
 		ExceptionPayload:    hexutil.Encode(input.Exception),
 		ProcessedInputCount: input.ProccessedInputCount,
+		Logs:                logs,
 	}
 }
@@ -160,6 +160,21 @@ func (s *InspectSuite) TestPostWithPayloadOverSizeLimit() {
 	s.Contains(body, "Payload reached size limit")
 }
 
+func (s *InspectSuite) TestPostWithLogs() {
+	s.model.On("AddInspectInput", []byte{}).Return(0)
+	s.model.On("GetInspectInput", 0).Return(model.InspectInput{
+		Index:  0,
+		Status: model.CompletionStatusAccepted,
+		Logs:   []model.Log{{Stream: "stdout", Line: "hello"}, {Stream: "stderr", Line: "world"}},
+	})
+	status, body := s.doPostInspect([]byte{})
+	s.Equal(http.StatusOK, status)
+	var result InspectResult
+	s.Require().Nil(json.Unmarshal([]byte(body), &result))
+	s.Require().NotNil(result.Logs)
+	s.Equal([]string{"hello", "world"}, *result.Logs)
+}
+
 func (s *InspectSuite) TestRequestFailsWithTimeout() {
 	s.model.On("AddInspectInput", []byte{}).Return(0)
 	s.model.On("GetInspectInput", 0).Return(model.InspectInput{
@@ -0,0 +1,102 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package libcmt
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+)
+
+// Functions libcmt uses to encode the advance inputs and the outputs.
+const ioJSON = `[
+  {"type": "function", "name": "EvmAdvance", "inputs": [
+    {"name": "chainId", "type": "uint256"},
+    {"name": "appContract", "type": "address"},
+    {"name": "msgSender", "type": "address"},
+    {"name": "blockNumber", "type": "uint256"},
+    {"name": "blockTimestamp", "type": "uint256"},
+    {"name": "prevRandao", "type": "uint256"},
+    {"name": "index", "type": "uint256"},
+    {"name": "payload", "type": "bytes"}
+  ]},
+  {"type": "function", "name": "Voucher", "inputs": [
+    {"name": "destination", "type": "address"},
+    {"name": "value", "type": "uint256"},
+    {"name": "payload", "type": "bytes"}
+  ]},
+  {"type": "function", "name": "Notice", "inputs": [
+    {"name": "payload", "type": "bytes"}
+  ]}
+]`
+
+var ioABI abi.ABI
+
+func init() {
+	var err error
+	ioABI, err = abi.JSON(strings.NewReader(ioJSON))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Encode the advance input the way libcmt expects it in the input file.
+// The model doesn't have the randao of the block, so it is always zero.
+func encodeAdvance(
+	input model.AdvanceInput,
+	chainID uint64,
+	application common.Address,
+) ([]byte, error) {
+	data, err := ioABI.Pack("EvmAdvance",
+		new(big.Int).SetUint64(chainID),
+		application,
+		input.MsgSender,
+		new(big.Int).SetUint64(input.BlockNumber),
+		big.NewInt(input.Timestamp.Unix()),
+		new(big.Int),
+		big.NewInt(int64(input.Index)),
+		input.Payload,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode advance: %w", err)
+	}
+	return data, nil
+}
+
+// Output decoded from the file written by libcmt.
+type output struct {
+	// Destination of the voucher; nil for notices.
+	destination *common.Address
+	value       *big.Int
+	payload     []byte
+}
+
+// Decode the voucher or notice written by libcmt.
+func decodeOutput(data []byte) (output, error) {
+	method, err := ioABI.MethodById(data)
+	if err != nil {
+		return output{}, fmt.Errorf("unknown output: %w", err)
+	}
+	values, err := method.Inputs.Unpack(data[len(method.ID):])
+	if err != nil {
+		return output{}, fmt.Errorf("failed to decode %v: %w", method.Name, err)
+	}
+	switch method.Name {
+	case "Voucher":
+		destination := values[0].(common.Address)
+		return output{
+			destination: &destination,
+			value:       values[1].(*big.Int),
+			payload:     values[2].([]byte),
+		}, nil
+	case "Notice":
+		return output{payload: values[0].([]byte)}, nil
+	default:
+		return output{}, fmt.Errorf("unknown output: %v", method.Name)
+	}
+}
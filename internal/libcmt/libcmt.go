@@ -0,0 +1,272 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+// This pkg drives applications built with the mock mode of libcmt, the low-level API of the
+// Cartesi machine. In mock mode, libcmt reads the inputs from the files listed in the CMT_INPUTS
+// environment variable and writes the outputs to files next to each input.
+//
+// The list of inputs is fixed when the application starts, so nonodo runs the application once
+// for each input. To rebuild the state of the application, nonodo replays the accepted advance
+// inputs before the current one and ignores their outputs.
+package libcmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/supervisor"
+)
+
+// Chain id of the local devnet.
+const DefaultChainID = 31337
+
+// Interval between checks for new inputs when the model is idle.
+const PollInterval = 100 * time.Millisecond
+
+// Permissions of the input files.
+const inputPermissions = 0600
+
+// Types of the inputs in the CMT_INPUTS variable.
+const (
+	inputTypeAdvance = 0
+	inputTypeInspect = 1
+)
+
+// This worker runs the libcmt application for each input of the model.
+//
+// The mock doesn't tell how the application finished the input, so the worker uses these
+// heuristics. If the application registered an exception, the input has an exception. If the
+// application was killed by a signal or stopped for exceeding a resource limit, the machine
+// halts. If it accepted an advance input, libcmt wrote the outputs root hash; otherwise, the
+// input is rejected. Inspect inputs are always accepted. So, an application that crashes with an
+// exit code rejects the input.
+type LibcmtWorker struct {
+	Model *model.NonodoModel
+
+	// Template of the application command; the worker sets the environment and the callbacks.
+	App supervisor.CommandWorker
+
+	ApplicationAddress common.Address
+	ChainID            uint64
+}
+
+func (w LibcmtWorker) String() string {
+	return "libcmt"
+}
+
+func (w LibcmtWorker) Start(ctx context.Context, ready chan<- struct{}) error {
+	ready <- struct{}{}
+	accepted := true
+	for {
+		input := w.Model.FinishAndGetNext(accepted)
+		if input == nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(PollInterval):
+			}
+			continue
+		}
+		var err error
+		accepted, err = w.process(ctx, input)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Run the application for the input and send its outputs to the model.
+// Return whether the application accepted the input.
+func (w LibcmtWorker) process(ctx context.Context, input model.Input) (bool, error) {
+	dir, err := os.MkdirTemp("", "nonodo-libcmt-")
+	if err != nil {
+		return false, fmt.Errorf("libcmt: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	base, inputs, err := w.writeInputs(dir, input)
+	if err != nil {
+		return false, fmt.Errorf("libcmt: %w", err)
+	}
+	exit, err := w.run(ctx, inputs)
+	if err != nil {
+		return false, err
+	}
+
+	// the reports are kept even if the input isn't accepted
+	if err := w.addReports(base); err != nil {
+		return false, fmt.Errorf("libcmt: %w", err)
+	}
+	exception, err := readException(base)
+	if err != nil {
+		return false, fmt.Errorf("libcmt: %w", err)
+	}
+	if exception != nil {
+		if err := w.Model.RegisterException(exception); err != nil {
+			return false, fmt.Errorf("libcmt: %w", err)
+		}
+		return true, nil
+	}
+	if exit.ExitCode < 0 || exit.Reason != "" {
+		w.Model.HaltCurrentInput(model.MachineHalt{
+			ExitCode: exit.ExitCode,
+			Stderr:   exit.Stderr,
+			Reason:   exit.Reason,
+		})
+		return true, nil
+	}
+	if _, ok := input.(model.InspectInput); ok {
+		return true, nil
+	}
+	if _, err := os.Stat(base + ".outputs_root_hash.bin"); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return false, fmt.Errorf("libcmt: %w", err)
+		}
+		return false, nil
+	}
+	if err := w.addOutputs(base); err != nil {
+		return false, fmt.Errorf("libcmt: %w", err)
+	}
+	return true, nil
+}
+
+// Write the replayed advance inputs and the current input to the directory.
+// Return the base path of the current input and the value of the CMT_INPUTS variable.
+func (w LibcmtWorker) writeInputs(dir string, input model.Input) (string, string, error) {
+	// replay the processed advances before the input
+	replay := -1
+	var base string
+	var inputType int
+	var data []byte
+	switch input := input.(type) {
+	case model.AdvanceInput:
+		replay = input.Index
+		base = filepath.Join(dir, fmt.Sprintf("advance-%v", input.Index))
+		inputType = inputTypeAdvance
+		var err error
+		data, err = encodeAdvance(input, w.ChainID, w.ApplicationAddress)
+		if err != nil {
+			return "", "", err
+		}
+	case model.InspectInput:
+		base = filepath.Join(dir, fmt.Sprintf("inspect-%v", input.Index))
+		inputType = inputTypeInspect
+		data = input.Payload
+	default:
+		return "", "", fmt.Errorf("invalid input type: %T", input)
+	}
+
+	// the files don't have an extension, so libcmt writes the outputs next to them
+	var inputs []string
+	for i := 0; i != replay; i++ {
+		advance, ok := w.Model.GetAdvanceInput(i)
+		if !ok || advance.Status == model.CompletionStatusUnprocessed {
+			break
+		}
+		if advance.Status != model.CompletionStatusAccepted {
+			continue
+		}
+		advanceData, err := encodeAdvance(advance, w.ChainID, w.ApplicationAddress)
+		if err != nil {
+			return "", "", err
+		}
+		path := filepath.Join(dir, fmt.Sprintf("replay-%v", i))
+		if err := os.WriteFile(path, advanceData, inputPermissions); err != nil {
+			return "", "", err
+		}
+		inputs = append(inputs, fmt.Sprintf("%v:%v", inputTypeAdvance, path))
+	}
+	if err := os.WriteFile(base, data, inputPermissions); err != nil {
+		return "", "", err
+	}
+	inputs = append(inputs, fmt.Sprintf("%v:%v", inputType, base))
+	return base, strings.Join(inputs, ","), nil
+}
+
+// Run the application until it exits.
+func (w LibcmtWorker) run(ctx context.Context, inputs string) (supervisor.CommandExit, error) {
+	var exit supervisor.CommandExit
+	app := w.App
+	app.Env = append(os.Environ(), "CMT_INPUTS="+inputs)
+	app.OnExit = func(e supervisor.CommandExit) {
+		exit = e
+	}
+	app.OnLog = w.Model.AddLog
+	err := app.Start(ctx, make(chan struct{}, 1))
+	if ctx.Err() != nil {
+		return exit, ctx.Err()
+	}
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return exit, fmt.Errorf("libcmt: failed to run application: %w", err)
+	}
+	return exit, nil
+}
+
+// Read the exception registered by the application, if there is one.
+func readException(base string) ([]byte, error) {
+	paths, err := filepath.Glob(base + ".exception*.bin")
+	if err != nil || len(paths) == 0 {
+		return nil, err
+	}
+	exception, err := os.ReadFile(paths[0])
+	if err != nil {
+		return nil, err
+	}
+	// distinguish an empty exception from no exception
+	return append([]byte{}, exception...), nil
+}
+
+// Send the vouchers and notices the application wrote for the input to the model.
+func (w LibcmtWorker) addOutputs(base string) error {
+	for i := 0; ; i++ {
+		data, err := os.ReadFile(fmt.Sprintf("%v.output-%v.bin", base, i))
+		if errors.Is(err, os.ErrNotExist) {
+			break
+		} else if err != nil {
+			return err
+		}
+		output, err := decodeOutput(data)
+		if err != nil {
+			return err
+		}
+		if output.destination == nil {
+			if _, err := w.Model.AddNotice(output.payload); err != nil {
+				return err
+			}
+			continue
+		}
+		if output.value.Sign() != 0 {
+			slog.Warn("libcmt: ignoring the value of the voucher", "value", output.value)
+		}
+		if _, err := w.Model.AddVoucher(*output.destination, output.payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Send the reports the application wrote for the input to the model.
+func (w LibcmtWorker) addReports(base string) error {
+	for i := 0; ; i++ {
+		data, err := os.ReadFile(fmt.Sprintf("%v.report-%v.bin", base, i))
+		if errors.Is(err, os.ErrNotExist) {
+			break
+		} else if err != nil {
+			return err
+		}
+		if err := w.Model.AddReport(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package libcmt
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/supervisor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Environment variable that makes the test binary act as a libcmt application in mock mode.
+const appHelperEnv = "NONODO_LIBCMT_TEST_HELPER"
+
+var testApplication = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+func TestMain(m *testing.M) {
+	if _, ok := os.LookupEnv(appHelperEnv); ok {
+		if err := appHelper(os.Getenv("CMT_INPUTS")); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// Process the inputs like a libcmt application that counts the accepted advances.
+func appHelper(inputs string) error {
+	count := 0
+	for _, input := range strings.Split(inputs, ",") {
+		inputType, path, _ := strings.Cut(input, ":")
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if inputType == fmt.Sprint(inputTypeInspect) {
+			err := os.WriteFile(path+".report-0.bin", []byte(fmt.Sprint(count)), 0600)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		method, err := ioABI.MethodById(data)
+		if err != nil {
+			return err
+		}
+		values, err := method.Inputs.Unpack(data[len(method.ID):])
+		if err != nil {
+			return err
+		}
+		if values[1].(common.Address) != testApplication {
+			return fmt.Errorf("wrong application")
+		}
+		sender := values[2].(common.Address)
+		payload := values[7].([]byte)
+		err = os.WriteFile(path+".report-0.bin", payload, 0600)
+		if err != nil {
+			return err
+		}
+		switch string(payload) {
+		case "reject":
+			continue
+		case "exception":
+			return os.WriteFile(path+".exception-0.bin", []byte("boom"), 0600)
+		}
+		count++
+		voucher, err := ioABI.Pack("Voucher", sender, new(big.Int), payload)
+		if err != nil {
+			return err
+		}
+		notice, err := ioABI.Pack("Notice", []byte(fmt.Sprint(count)))
+		if err != nil {
+			return err
+		}
+		files := map[string][]byte{
+			".output-0.bin":          voucher,
+			".output-1.bin":          notice,
+			".outputs_root_hash.bin": make([]byte, common.HashLength),
+		}
+		for suffix, content := range files {
+			if err := os.WriteFile(path+suffix, content, 0600); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func TestLibcmtWorker(t *testing.T) {
+	m := model.NewNonodoModel()
+	sender := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	for _, payload := range []string{"first", "reject", "exception", "second"} {
+		m.AddAdvanceInput(sender, []byte(payload), 0, time.Now())
+	}
+	worker := LibcmtWorker{
+		Model: m,
+		App: supervisor.CommandWorker{
+			Name:    "app",
+			Command: os.Args[0],
+		},
+		ApplicationAddress: testApplication,
+		ChainID:            DefaultChainID,
+	}
+	t.Setenv(appHelperEnv, "1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result := make(chan error)
+	go func() {
+		result <- worker.Start(ctx, make(chan struct{}, 1))
+	}()
+	require.Eventually(t, func() bool {
+		input, _ := m.GetAdvanceInput(3)
+		return input.Status != model.CompletionStatusUnprocessed
+	}, 10*time.Second, 10*time.Millisecond)
+	index := m.AddInspectInput([]byte("count"))
+	require.Eventually(t, func() bool {
+		return m.GetInspectInput(index).Status != model.CompletionStatusUnprocessed
+	}, 10*time.Second, 10*time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+
+	expected := []struct {
+		status    model.CompletionStatus
+		notice    string
+		exception string
+	}{
+		{model.CompletionStatusAccepted, "1", ""},
+		{model.CompletionStatusRejected, "", ""},
+		{model.CompletionStatusException, "", "boom"},
+		{model.CompletionStatusAccepted, "2", ""},
+	}
+	for i, e := range expected {
+		input, ok := m.GetAdvanceInput(i)
+		require.True(t, ok)
+		assert.Equal(t, e.status, input.Status, i)
+		require.Len(t, input.Reports, 1, i)
+		assert.Equal(t, input.Payload, input.Reports[0].Payload, i)
+		assert.Equal(t, e.exception, string(input.Exception), i)
+		if e.notice == "" {
+			assert.Empty(t, input.Vouchers, i)
+			assert.Empty(t, input.Notices, i)
+			continue
+		}
+		require.Len(t, input.Vouchers, 1, i)
+		assert.Equal(t, sender, input.Vouchers[0].Destination, i)
+		assert.Equal(t, input.Payload, input.Vouchers[0].Payload, i)
+		require.Len(t, input.Notices, 1, i)
+		assert.Equal(t, e.notice, string(input.Notices[0].Payload), i)
+	}
+	inspect := m.GetInspectInput(index)
+	assert.Equal(t, model.CompletionStatusAccepted, inspect.Status)
+	require.Len(t, inspect.Reports, 1)
+	assert.Equal(t, "2", string(inspect.Reports[0].Payload))
+}
+
+func TestDecodeOutputErrors(t *testing.T) {
+	_, err := decodeOutput([]byte{0xde, 0xad})
+	assert.NotNil(t, err)
+	advance, err := encodeAdvance(model.AdvanceInput{}, DefaultChainID, testApplication)
+	require.Nil(t, err)
+	_, err = decodeOutput(advance)
+	assert.NotNil(t, err)
+}
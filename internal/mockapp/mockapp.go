@@ -0,0 +1,174 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+// This pkg is a mock application that responds to the inputs following scripted rules.
+// It is useful to exercise the front-end states without the real application.
+package mockapp
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gligneul/nonodo/internal/rollup"
+)
+
+// This worker uses the rollup API to respond to the inputs following the rules.
+// Inputs that don't match any rule are accepted without outputs.
+type MockAppWorker struct {
+	RollupEndpoint string
+	Rules          *Rules
+}
+
+func (w MockAppWorker) String() string {
+	return "mock"
+}
+
+func (w MockAppWorker) Start(ctx context.Context, ready chan<- struct{}) error {
+	client, err := rollup.NewClientWithResponses(w.RollupEndpoint)
+	if err != nil {
+		return fmt.Errorf("mock: %w", err)
+	}
+
+	ready <- struct{}{}
+
+	finishReq := rollup.Finish{
+		Status: rollup.Accept,
+	}
+	for {
+		finishResp, err := client.FinishWithResponse(ctx, finishReq)
+		if err != nil {
+			return fmt.Errorf("mock: %w", err)
+		}
+		if finishResp.StatusCode() == http.StatusAccepted {
+			continue
+		}
+		if finishResp.StatusCode() != http.StatusOK {
+			return fmt.Errorf("mock: invalid finish response: status=%v body=`%v`",
+				finishResp.StatusCode(), string(finishResp.Body))
+		}
+		finishBody := finishResp.JSON200
+		if finishBody == nil {
+			return fmt.Errorf("mock: missing finish response body")
+		}
+		var kind string
+		var sender common.Address
+		var payload string
+		switch finishBody.RequestType {
+		case rollup.AdvanceState:
+			advance, err := finishBody.Data.AsAdvance()
+			if err != nil {
+				return fmt.Errorf("mock: failed to parser advance: %w", err)
+			}
+			kind = InputKindAdvance
+			sender = common.HexToAddress(advance.Metadata.MsgSender)
+			payload = advance.Payload
+		case rollup.InspectState:
+			inspect, err := finishBody.Data.AsInspect()
+			if err != nil {
+				return fmt.Errorf("mock: failed to parser inspect: %w", err)
+			}
+			kind = InputKindInspect
+			payload = inspect.Payload
+		default:
+			return fmt.Errorf("mock: invalid request type: %v", finishBody.RequestType)
+		}
+		data, err := hexutil.Decode(payload)
+		if err != nil {
+			return fmt.Errorf("mock: invalid payload: %w", err)
+		}
+		rule := w.Rules.Find(kind, sender, data)
+		if rule == nil {
+			slog.Info("mock: no rule matched the input", "kind", kind)
+			finishReq.Status = rollup.Accept
+			continue
+		}
+		slog.Info("mock: handling input", "kind", kind, "rule", rule.Name)
+		finishReq.Status, err = respond(ctx, client, rule.Respond)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Send the response to the rollup API.
+// Return the finish status of the input.
+func respond(
+	ctx context.Context,
+	client *rollup.ClientWithResponses,
+	response Response,
+) (rollup.FinishStatus, error) {
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-time.After(response.Delay):
+	}
+
+	for _, voucher := range response.Vouchers {
+		voucherReq := rollup.Voucher{
+			Destination: voucher.Destination,
+			Payload:     hexutil.Encode(voucher.Payload),
+		}
+		voucherResp, err := client.AddVoucher(ctx, voucherReq)
+		if err != nil {
+			return "", fmt.Errorf("mock: %w", err)
+		}
+		if voucherResp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("mock: failed to add voucher")
+		}
+	}
+
+	for _, notice := range response.Notices {
+		noticeReq := rollup.Notice{
+			Payload: hexutil.Encode(notice.Payload),
+		}
+		noticeResp, err := client.AddNotice(ctx, noticeReq)
+		if err != nil {
+			return "", fmt.Errorf("mock: %w", err)
+		}
+		if noticeResp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("mock: failed to add notice")
+		}
+	}
+
+	for _, report := range response.Reports {
+		reportReq := rollup.Report{
+			Payload: hexutil.Encode(report.Payload),
+		}
+		reportResp, err := client.AddReport(ctx, reportReq)
+		if err != nil {
+			return "", fmt.Errorf("mock: %w", err)
+		}
+		if reportResp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("mock: failed to add report")
+		}
+	}
+
+	switch response.Status {
+	case StatusRejected:
+		return rollup.Reject, nil
+	case StatusException:
+		var exception []byte
+		if response.Exception != nil {
+			exception = *response.Exception
+		}
+		exceptionReq := rollup.Exception{
+			Payload: hexutil.Encode(exception),
+		}
+		exceptionResp, err := client.RegisterException(ctx, exceptionReq)
+		if err != nil {
+			return "", fmt.Errorf("mock: %w", err)
+		}
+		if exceptionResp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("mock: failed to register exception")
+		}
+		// the model ignores the finish status after an exception
+		return rollup.Accept, nil
+	default:
+		return rollup.Accept, nil
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package mockapp
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/rollup"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRules = `
+rules:
+  - name: deposit
+    match:
+      kind: advance
+      sender: "0x00000000000000000000000000000000000000aa"
+      json:
+        - path: $.method
+          value: deposit
+    respond:
+      vouchers:
+        - destination: "0x00000000000000000000000000000000000000bb"
+          payload: 0xdeadbeef
+      notices:
+        - payload: deposited
+  - name: withdraw
+    match:
+      kind: advance
+      prefix: withdraw
+    respond:
+      status: rejected
+      reports:
+        - payload: insufficient funds
+  - name: crash
+    match:
+      regex: "^cr+ash$"
+    respond:
+      status: exception
+      exception: boom
+  - name: balance
+    match:
+      kind: inspect
+      payload: balance
+    respond:
+      delay: 10ms
+      reports:
+        - payload:
+            json: {balance: 42}
+`
+
+var (
+	testSender = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	otherAddr  = common.HexToAddress("0x00000000000000000000000000000000000000cc")
+)
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules([]byte(testRules))
+	require.Nil(t, err)
+	require.Len(t, rules.Rules, 4)
+	assert.Equal(t, StatusAccepted, rules.Rules[0].Respond.Status)
+	assert.Equal(t, 10*time.Millisecond, rules.Rules[3].Respond.Delay)
+	assert.Equal(t, `{"balance":42}`, string(rules.Rules[3].Respond.Reports[0].Payload))
+}
+
+func TestParseRulesErrors(t *testing.T) {
+	invalid := map[string]string{
+		"kind":      "rules: [{match: {kind: foo}}]",
+		"sender":    "rules: [{match: {sender: foo}}]",
+		"regex":     "rules: [{match: {regex: '('}}]",
+		"path":      "rules: [{match: {json: [{path: foo}]}}]",
+		"status":    "rules: [{respond: {status: foo}}]",
+		"exception": "rules: [{respond: {exception: foo}}]",
+		"notice":    "rules: [{match: {kind: inspect}, respond: {notices: [{payload: foo}]}}]",
+		"field":     "rules: [{foo: bar}]",
+	}
+	for name, rules := range invalid {
+		_, err := ParseRules([]byte(rules))
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestRulesFind(t *testing.T) {
+	rules, err := ParseRules([]byte(testRules))
+	require.Nil(t, err)
+	find := func(kind string, sender common.Address, payload string) string {
+		rule := rules.Find(kind, sender, []byte(payload))
+		if rule == nil {
+			return ""
+		}
+		return rule.Name
+	}
+	assert.Equal(t, "deposit", find(InputKindAdvance, testSender, `{"method":"deposit"}`))
+	assert.Equal(t, "", find(InputKindAdvance, otherAddr, `{"method":"deposit"}`))
+	assert.Equal(t, "", find(InputKindAdvance, testSender, `{"method":"other"}`))
+	assert.Equal(t, "withdraw", find(InputKindAdvance, otherAddr, "withdraw 10"))
+	assert.Equal(t, "", find(InputKindInspect, otherAddr, "withdraw 10"))
+	assert.Equal(t, "crash", find(InputKindAdvance, otherAddr, "crrrash"))
+	assert.Equal(t, "crash", find(InputKindInspect, common.Address{}, "crash"))
+	assert.Equal(t, "balance", find(InputKindInspect, common.Address{}, "balance"))
+	assert.Equal(t, "", find(InputKindInspect, common.Address{}, "balance?"))
+}
+
+func TestMockAppWorker(t *testing.T) {
+	rules, err := ParseRules([]byte(testRules))
+	require.Nil(t, err)
+	m := model.NewNonodoModel()
+	e := echo.New()
+	rollup.Register(e, m)
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	w := MockAppWorker{
+		RollupEndpoint: server.URL + "/rollup",
+		Rules:          rules,
+	}
+	result := make(chan error, 1)
+	go func() {
+		result <- w.Start(ctx, make(chan struct{}, 1))
+	}()
+
+	m.AddAdvanceInput(testSender, []byte(`{"method":"deposit"}`), 0, time.Now())
+	m.AddAdvanceInput(otherAddr, []byte("withdraw 10"), 0, time.Now())
+	m.AddAdvanceInput(otherAddr, []byte("crash"), 0, time.Now())
+	m.AddAdvanceInput(otherAddr, []byte("unknown"), 0, time.Now())
+	inspect := m.AddInspectInput([]byte("balance"))
+	require.Eventually(t, func() bool {
+		last, _ := m.GetAdvanceInput(3)
+		return last.Status != model.CompletionStatusUnprocessed &&
+			m.GetInspectInput(inspect).Status != model.CompletionStatusUnprocessed
+	}, 5*time.Second, 10*time.Millisecond)
+
+	deposit, _ := m.GetAdvanceInput(0)
+	assert.Equal(t, model.CompletionStatusAccepted, deposit.Status)
+	require.Len(t, deposit.Vouchers, 1)
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, deposit.Vouchers[0].Payload)
+	require.Len(t, deposit.Notices, 1)
+	assert.Equal(t, "deposited", string(deposit.Notices[0].Payload))
+
+	withdraw, _ := m.GetAdvanceInput(1)
+	assert.Equal(t, model.CompletionStatusRejected, withdraw.Status)
+	require.Len(t, withdraw.Reports, 1)
+	assert.Equal(t, "insufficient funds", string(withdraw.Reports[0].Payload))
+
+	crash, _ := m.GetAdvanceInput(2)
+	assert.Equal(t, model.CompletionStatusException, crash.Status)
+	assert.Equal(t, "boom", string(crash.Exception))
+
+	unknown, _ := m.GetAdvanceInput(3)
+	assert.Equal(t, model.CompletionStatusAccepted, unknown.Status)
+	assert.Empty(t, unknown.Notices)
+
+	balance := m.GetInspectInput(inspect)
+	assert.Equal(t, model.CompletionStatusAccepted, balance.Status)
+	require.Len(t, balance.Reports, 1)
+	assert.Equal(t, `{"balance":42}`, string(balance.Reports[0].Payload))
+
+	cancel()
+	assert.NotNil(t, <-result)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package mockapp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/payload"
+	"gopkg.in/yaml.v3"
+)
+
+// Kinds of inputs matched by the rules.
+const (
+	InputKindAdvance = "advance"
+	InputKindInspect = "inspect"
+)
+
+// Statuses of the responses.
+const (
+	StatusAccepted  = "accepted"
+	StatusRejected  = "rejected"
+	StatusException = "exception"
+)
+
+// File with the rules of the mock backend.
+type Rules struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+// Rule that responds to the inputs it matches.
+// The rules are checked in order, and the first one that matches the input responds to it.
+type Rule struct {
+	Name    string   `yaml:"name"`
+	Match   Match    `yaml:"match"`
+	Respond Response `yaml:"respond"`
+}
+
+// Condition to match an input.
+// Only the fields that are set are checked.
+type Match struct {
+	Kind    string           `yaml:"kind"`
+	Sender  string           `yaml:"sender"`
+	Payload *payload.Payload `yaml:"payload"`
+	Prefix  *payload.Payload `yaml:"prefix"`
+	Regex   string           `yaml:"regex"`
+	JSON    []JSONMatch      `yaml:"json"`
+	sender  common.Address   `yaml:"-"`
+	regex   *regexp.Regexp   `yaml:"-"`
+}
+
+// Value in the given path of a JSON payload.
+// The path has the format $.field.array[0].
+type JSONMatch struct {
+	Path  string `yaml:"path"`
+	Value any    `yaml:"value"`
+	steps []any  `yaml:"-"`
+	value any    `yaml:"-"`
+}
+
+// Response to the matched input.
+type Response struct {
+	Delay     time.Duration    `yaml:"delay"`
+	Vouchers  []Voucher        `yaml:"vouchers"`
+	Notices   []Output         `yaml:"notices"`
+	Reports   []Output         `yaml:"reports"`
+	Status    string           `yaml:"status"`
+	Exception *payload.Payload `yaml:"exception"`
+}
+
+// Voucher sent in the response.
+type Voucher struct {
+	Destination string          `yaml:"destination"`
+	Payload     payload.Payload `yaml:"payload"`
+}
+
+// Notice or report sent in the response.
+type Output struct {
+	Payload payload.Payload `yaml:"payload"`
+}
+
+// Load the rules from the given file.
+func LoadRules(path string) (*Rules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("mock: %w", err)
+	}
+	return ParseRules(data)
+}
+
+// Parse the rules and validate them.
+func ParseRules(data []byte) (*Rules, error) {
+	var rules Rules
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&rules); err != nil {
+		return nil, fmt.Errorf("mock: parse: %w", err)
+	}
+	for i := range rules.Rules {
+		r := &rules.Rules[i]
+		if r.Name == "" {
+			r.Name = fmt.Sprintf("rule %v", i)
+		}
+		if err := r.validate(); err != nil {
+			return nil, fmt.Errorf("mock: %v: %w", r.Name, err)
+		}
+	}
+	return &rules, nil
+}
+
+// Find the first rule that matches the input.
+// Return nil if there is none.
+func (r *Rules) Find(kind string, sender common.Address, data []byte) *Rule {
+	for i := range r.Rules {
+		if r.Rules[i].Match.matches(kind, sender, data) {
+			return &r.Rules[i]
+		}
+	}
+	return nil
+}
+
+func (r *Rule) validate() error {
+	if err := r.Match.validate(); err != nil {
+		return err
+	}
+	if err := r.Respond.validate(); err != nil {
+		return err
+	}
+	if r.Match.Kind != InputKindAdvance &&
+		(len(r.Respond.Vouchers) > 0 || len(r.Respond.Notices) > 0) {
+		return fmt.Errorf("only advance inputs produce vouchers or notices")
+	}
+	return nil
+}
+
+func (m *Match) validate() error {
+	switch m.Kind {
+	case "", InputKindAdvance, InputKindInspect:
+	default:
+		return fmt.Errorf("invalid kind: %v", m.Kind)
+	}
+	if m.Sender != "" {
+		if m.Kind == InputKindInspect {
+			return fmt.Errorf("inspect inputs don't have a sender")
+		}
+		if !common.IsHexAddress(m.Sender) {
+			return fmt.Errorf("invalid sender: %v", m.Sender)
+		}
+		m.sender = common.HexToAddress(m.Sender)
+	}
+	if m.Regex != "" {
+		regex, err := regexp.Compile(m.Regex)
+		if err != nil {
+			return fmt.Errorf("invalid regex: %w", err)
+		}
+		m.regex = regex
+	}
+	for i := range m.JSON {
+		j := &m.JSON[i]
+		steps, err := payload.ParseJSONPath(j.Path)
+		if err != nil {
+			return err
+		}
+		j.steps = steps
+		j.value, err = payload.NormalizeJSON(j.Value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Response) validate() error {
+	if r.Delay < 0 {
+		return fmt.Errorf("delay cannot be negative")
+	}
+	switch strings.ToLower(r.Status) {
+	case "":
+		r.Status = StatusAccepted
+	case StatusAccepted, StatusRejected, StatusException:
+		r.Status = strings.ToLower(r.Status)
+	default:
+		return fmt.Errorf("invalid status: %v", r.Status)
+	}
+	if r.Exception != nil && r.Status != StatusException {
+		return fmt.Errorf("must set the status to exception when setting the exception payload")
+	}
+	for _, voucher := range r.Vouchers {
+		if !common.IsHexAddress(voucher.Destination) {
+			return fmt.Errorf("invalid voucher destination: %v", voucher.Destination)
+		}
+	}
+	return nil
+}
+
+// Check whether the input matches the condition.
+// The sender is ignored for inspect inputs.
+func (m *Match) matches(kind string, sender common.Address, data []byte) bool {
+	if m.Kind != "" && m.Kind != kind {
+		return false
+	}
+	if m.Sender != "" && (kind != InputKindAdvance || m.sender != sender) {
+		return false
+	}
+	if m.Payload != nil && !bytes.Equal(*m.Payload, data) {
+		return false
+	}
+	if m.Prefix != nil && !bytes.HasPrefix(data, *m.Prefix) {
+		return false
+	}
+	if m.regex != nil && !m.regex.Match(data) {
+		return false
+	}
+	if len(m.JSON) > 0 {
+		var document any
+		if err := json.Unmarshal(data, &document); err != nil {
+			return false
+		}
+		for _, j := range m.JSON {
+			value, ok := payload.LookupJSONPath(document, j.steps)
+			if !ok || !reflect.DeepEqual(j.value, value) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -17,10 +17,35 @@ import (
 // Nonodo model shared among the internal workers.
 // The model store inputs as pointers because these pointers are shared with the rollup state.
 type NonodoModel struct {
-	mutex    sync.Mutex
-	advances []*AdvanceInput
-	inspects []*InspectInput
-	state    rollupsState
+	mutex     sync.Mutex
+	advances  []*AdvanceInput
+	inspects  []*InspectInput
+	state     rollupsState
+	observers []InputObserver
+	meter     UsageMeter
+	logSync   func()
+
+	// Index of each advance input from the InputBox, which excludes the sequenced inputs.
+	inputBoxIndices []int
+
+	// InputBox inputs that were not released yet, which come after the advance inputs.
+	pending []PendingInput
+
+	// Time when the application received the current input.
+	startedAt time.Time
+
+	// Conformance violations in requests made while the model was idle.
+	idleViolations []string
+}
+
+// Observer of the inputs processed by the application.
+// The model calls the observer while holding its lock, so the observer must not call the model.
+type InputObserver interface {
+	// Called when the application starts processing the input.
+	InputStarted(input Input)
+
+	// Called when the application finishes processing the input, including exceptions and halts.
+	InputFinished(input Input)
 }
 
 // Create a new model.
@@ -30,6 +55,51 @@ func NewNonodoModel() *NonodoModel {
 	}
 }
 
+// Measures the resources used by the application while it processes an input.
+// Sampling might be slow, so the model takes the samples before acquiring its lock and passes
+// them to Start and Stop, which it calls while holding the lock.
+// The meter must not call the model.
+type UsageMeter interface {
+	// Sample the resources used by the application so far.
+	// Return false if the resources are not available.
+	Sample() (UsageSample, bool)
+
+	// Start measuring the resources for a new input from the sample.
+	// The sample is nil if the resources are not available.
+	Start(sample *UsageSample)
+
+	// Stop measuring at the sample and return the resources used since the start.
+	// The sample is nil if the resources are not available.
+	// Return false if the resources are not available.
+	Stop(sample *UsageSample) (ResourceUsage, bool)
+}
+
+// Set the meter that measures the resources used by the application for each input.
+func (m *NonodoModel) SetUsageMeter(meter UsageMeter) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.meter = meter
+}
+
+// Set the function that reads the output the application wrote so far, reporting it with AddLog.
+// The model calls it before finishing each input, so the lines the application wrote right before
+// finishing the input are attributed to it.
+func (m *NonodoModel) SetLogSync(logSync func()) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.logSync = logSync
+}
+
+// Add an observer that is notified when the application starts and finishes each input.
+func (m *NonodoModel) AddObserver(observer InputObserver) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.observers = append(m.observers, observer)
+}
+
 //
 // Methods for Inputter
 //
@@ -44,6 +114,114 @@ func (m *NonodoModel) AddAdvanceInput(
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	m.addAdvanceInput(sender, payload, blockNumber, timestamp)
+}
+
+// Hold the advance input until it is released.
+func (m *NonodoModel) AddPendingInput(input PendingInput) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.pending = append(m.pending, input)
+	slog.Info("nonodo: added pending input", "sender", input.MsgSender,
+		"payload", hexutil.Encode(input.Payload), "releaseAt", input.ReleaseAt,
+		"releaseBlock", input.ReleaseBlock)
+}
+
+// Add the pending inputs released at the time and block to the advance inputs.
+// The inputs are released in order, so an input waits for the previous ones.
+// Return the number of released inputs.
+func (m *NonodoModel) ReleasePendingInputs(now time.Time, block uint64) int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	released := 0
+	for _, input := range m.pending {
+		if now.Before(input.ReleaseAt) || block < input.ReleaseBlock {
+			break
+		}
+		m.addAdvanceInput(input.MsgSender, input.Payload, input.BlockNumber, input.Timestamp)
+		released++
+	}
+	m.pending = m.pending[released:]
+	return released
+}
+
+// Get the number of advance inputs from the InputBox, excluding the sequenced inputs and
+// including the pending inputs.
+// The inputter compares this number with the input index of the InputBox events.
+func (m *NonodoModel) GetNumInputBoxInputs() int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return len(m.inputBoxIndices) + len(m.pending)
+}
+
+// Remove the InputBox inputs after the first count ones, which were orphaned by a reorg.
+// The remaining inputs are renumbered, marked as unprocessed, and lose their outputs; so, the
+// application should process the whole history again after restarting.
+// If only pending inputs were orphaned, remove them and keep the advance inputs.
+// Return the number of removed inputs.
+func (m *NonodoModel) RollbackInputBoxInputs(count int) int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if count >= len(m.inputBoxIndices) {
+		kept := min(count-len(m.inputBoxIndices), len(m.pending))
+		removed := len(m.pending) - kept
+		m.pending = m.pending[:kept]
+		if removed > 0 {
+			slog.Warn("nonodo: rolled back pending inputs", "removed", removed)
+		}
+		return removed
+	}
+	pending := len(m.pending)
+	m.pending = nil
+	kept := make(map[int]bool)
+	for _, index := range m.inputBoxIndices[:count] {
+		kept[index] = true
+	}
+	removed := make(map[int]bool)
+	for _, index := range m.inputBoxIndices[count:] {
+		removed[index] = true
+	}
+	var advances []*AdvanceInput
+	m.inputBoxIndices = m.inputBoxIndices[:0]
+	for _, input := range m.advances {
+		if removed[input.Index] {
+			continue
+		}
+		if kept[input.Index] {
+			m.inputBoxIndices = append(m.inputBoxIndices, len(advances))
+		}
+		input.Index = len(advances)
+		advances = append(advances, input)
+	}
+	m.advances = advances
+	m.resetAdvanceInputs()
+	slog.Warn("nonodo: rolled back inputs", "removed", len(removed)+pending,
+		"remaining", len(m.advances))
+	return len(removed) + pending
+}
+
+//
+// Methods for Sequencer
+//
+
+// Add an advance input received by the sequencer to the model.
+// The input takes the block number of the last advance input, so the block numbers of the
+// inputs never decrease. Return the input index.
+func (m *NonodoModel) AddSequencedInput(
+	sender common.Address,
+	payload []byte,
+	timestamp time.Time,
+) int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var blockNumber uint64
+	if len(m.advances) > 0 {
+		blockNumber = m.advances[len(m.advances)-1].BlockNumber
+	}
 	index := len(m.advances)
 	input := AdvanceInput{
 		Index:       index,
@@ -54,8 +232,9 @@ func (m *NonodoModel) AddAdvanceInput(
 		BlockNumber: blockNumber,
 	}
 	m.advances = append(m.advances, &input)
-	slog.Info("nonodo: added advance input", "index", input.Index, "sender", input.MsgSender,
+	slog.Info("nonodo: added sequenced input", "index", input.Index, "sender", input.MsgSender,
 		"payload", hexutil.Encode(input.Payload))
+	return index
 }
 
 //
@@ -99,6 +278,8 @@ func (m *NonodoModel) GetInspectInput(index int) InspectInput {
 // Finish the current input and get the next one.
 // If there is no input to be processed return nil.
 func (m *NonodoModel) FinishAndGetNext(accepted bool) Input {
+	m.syncLogs()
+	sample := m.sampleUsage()
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
@@ -109,12 +290,15 @@ func (m *NonodoModel) FinishAndGetNext(accepted bool) Input {
 	} else {
 		status = CompletionStatusRejected
 	}
+	m.recordUsage(sample)
 	m.state.finish(status)
+	m.notifyFinished()
 
 	// try to get first unprocessed inspect
 	for _, input := range m.inspects {
 		if input.Status == CompletionStatusUnprocessed {
 			m.state = newRollupsStateInspect(input, m.getProccessedInputCount)
+			m.startUsage(sample)
 			return *input
 		}
 	}
@@ -123,6 +307,7 @@ func (m *NonodoModel) FinishAndGetNext(accepted bool) Input {
 	for _, input := range m.advances {
 		if input.Status == CompletionStatusUnprocessed {
 			m.state = newRollupsStateAdvance(input)
+			m.startUsage(sample)
 			return *input
 		}
 	}
@@ -164,19 +349,115 @@ func (m *NonodoModel) AddReport(payload []byte) error {
 // Finish the current input with an exception.
 // Return an error if the state isn't advance or inspect.
 func (m *NonodoModel) RegisterException(payload []byte) error {
+	m.syncLogs()
+	sample := m.sampleUsage()
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	m.recordUsage(sample)
 	err := m.state.registerException(payload)
 	if err != nil {
 		return err
 	}
+	m.notifyFinished()
 
 	// set state to idle
 	m.state = newRollupsStateIdle()
 	return nil
 }
 
+// Get the input being processed by the application.
+// Return nil if the model is idle.
+func (m *NonodoModel) GetCurrentInput() Input {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	return m.state.current()
+}
+
+// Add a conformance violation of the rollup API to the input being processed.
+// If the model is idle, the violation is stored apart from the inputs.
+func (m *NonodoModel) AddViolation(violation string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.state.current() == nil {
+		m.idleViolations = append(m.idleViolations, violation)
+		return
+	}
+	m.state.addViolation(violation)
+}
+
+//
+// Methods for Supervisor
+//
+
+// Discard the outputs of the input being processed and go back to the idle state.
+// The input remains unprocessed, so the application receives it again after restarting.
+func (m *NonodoModel) ResetCurrentInput() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.discardUsage()
+	m.state = newRollupsStateIdle()
+}
+
+// Mark the input being processed as halted, discarding its outputs, and go back to the idle
+// state. The supervisor calls this method when the application exits unexpectedly.
+func (m *NonodoModel) HaltCurrentInput(info MachineHalt) {
+	sample := m.sampleUsage()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.recordUsage(sample)
+	m.state.halt(info)
+	m.notifyFinished()
+	m.state = newRollupsStateIdle()
+}
+
+// Add a line the application wrote to stdout or stderr to the input being processed.
+// If the model is idle, the line is discarded.
+func (m *NonodoModel) AddLog(stream string, line string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.state.addLog(Log{
+		Stream:    stream,
+		Line:      line,
+		Timestamp: time.Now(),
+	})
+}
+
+// Add an InputBox input to the advance inputs.
+func (m *NonodoModel) addAdvanceInput(
+	sender common.Address,
+	payload []byte,
+	blockNumber uint64,
+	timestamp time.Time,
+) {
+	index := len(m.advances)
+	input := AdvanceInput{
+		Index:       index,
+		Status:      CompletionStatusUnprocessed,
+		MsgSender:   sender,
+		Payload:     payload,
+		Timestamp:   timestamp,
+		BlockNumber: blockNumber,
+	}
+	m.advances = append(m.advances, &input)
+	m.inputBoxIndices = append(m.inputBoxIndices, index)
+	slog.Info("nonodo: added advance input", "index", input.Index, "sender", input.MsgSender,
+		"payload", hexutil.Encode(input.Payload))
+}
+
+// Mark all advance inputs as unprocessed and discard their outputs.
+// This way, the application processes the whole history again after restarting.
+func (m *NonodoModel) ResetAdvanceInputs() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.resetAdvanceInputs()
+}
+
 //
 // Methods for Reader
 //
@@ -360,10 +641,61 @@ func (m *NonodoModel) GetReports(filter OutputFilter, offset int, limit int) []R
 	return paginate(reports, offset, limit)
 }
 
+// Get the inputs that were not released yet.
+func (m *NonodoModel) GetPendingInputs() []PendingInput {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	pending := make([]PendingInput, len(m.pending))
+	copy(pending, m.pending)
+	return pending
+}
+
+// Get the conformance violations of the processed inputs.
+func (m *NonodoModel) GetViolations() Violations {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	violations := Violations{
+		Advances: make(map[int][]string),
+		Inspects: make(map[int][]string),
+		Idle:     m.idleViolations,
+	}
+	for _, input := range m.advances {
+		if len(input.Violations) > 0 {
+			violations.Advances[input.Index] = input.Violations
+		}
+	}
+	for _, input := range m.inspects {
+		if len(input.Violations) > 0 {
+			violations.Inspects[input.Index] = input.Violations
+		}
+	}
+	return violations
+}
+
 //
 // Auxiliary Methods
 //
 
+// Mark all advance inputs as unprocessed and discard their outputs.
+func (m *NonodoModel) resetAdvanceInputs() {
+	m.discardUsage()
+	m.state = newRollupsStateIdle()
+	for _, input := range m.advances {
+		input.Status = CompletionStatusUnprocessed
+		input.Vouchers = nil
+		input.Notices = nil
+		input.Reports = nil
+		input.Exception = nil
+		input.MachineHalt = nil
+		input.Usage = nil
+		input.Logs = nil
+		input.Violations = nil
+	}
+	slog.Info("nonodo: reset advance inputs", "count", len(m.advances))
+}
+
 func (m *NonodoModel) getProccessedInputCount() int {
 	n := 0
 	for _, input := range m.advances {
@@ -375,6 +707,90 @@ func (m *NonodoModel) getProccessedInputCount() int {
 	return n
 }
 
+// Read the output the application wrote so far without holding the lock, since the output is
+// reported with AddLog.
+func (m *NonodoModel) syncLogs() {
+	m.mutex.Lock()
+	logSync := m.logSync
+	m.mutex.Unlock()
+
+	if logSync != nil {
+		logSync()
+	}
+}
+
+// Sample the resources used by the application without holding the lock.
+// Return nil if there is no meter or the resources are not available.
+func (m *NonodoModel) sampleUsage() *UsageSample {
+	m.mutex.Lock()
+	meter := m.meter
+	m.mutex.Unlock()
+
+	if meter == nil {
+		return nil
+	}
+	sample, ok := meter.Sample()
+	if !ok {
+		return nil
+	}
+	return &sample
+}
+
+// Start measuring the usage of the current input from the sample and notify the observers.
+func (m *NonodoModel) startUsage(sample *UsageSample) {
+	m.startedAt = time.Now()
+	if m.meter != nil {
+		m.meter.Start(sample)
+	}
+	m.notifyStarted()
+}
+
+// Record the usage of the current input up to the sample.
+// Do nothing if there is no current input.
+func (m *NonodoModel) recordUsage(sample *UsageSample) {
+	input := m.state.current()
+	if input == nil {
+		return
+	}
+	usage := InputUsage{
+		StartedAt:  m.startedAt,
+		FinishedAt: time.Now(),
+	}
+	if m.meter != nil {
+		if resources, ok := m.meter.Stop(sample); ok {
+			usage.Resources = &resources
+		}
+	}
+	m.state.setUsage(usage)
+}
+
+// Stop measuring the usage of the current input without recording it.
+func (m *NonodoModel) discardUsage() {
+	if m.meter != nil && m.state.current() != nil {
+		m.meter.Stop(nil)
+	}
+}
+
+// Notify the observers that the current input started.
+func (m *NonodoModel) notifyStarted() {
+	input := m.state.current()
+	for _, observer := range m.observers {
+		observer.InputStarted(input)
+	}
+}
+
+// Notify the observers that the current input finished.
+// Do nothing if there is no current input.
+func (m *NonodoModel) notifyFinished() {
+	input := m.state.current()
+	if input == nil {
+		return
+	}
+	for _, observer := range m.observers {
+		observer.InputFinished(input)
+	}
+}
+
 func paginate[T any](slice []T, offset int, limit int) []T {
 	if offset >= len(slice) {
 		return nil
@@ -5,6 +5,7 @@ package model
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -76,6 +77,105 @@ func (s *ModelSuite) TestItAddsAndGetsAdvanceInputs() {
 	}
 }
 
+//
+// AddSequencedInput
+//
+
+func (s *ModelSuite) TestItAddsSequencedInputAtLastBlock() {
+	index := s.m.AddSequencedInput(s.senders[0], s.payloads[0], s.timestamps[0])
+	s.Equal(0, index)
+	s.m.AddAdvanceInput(s.senders[1], s.payloads[1], 10, s.timestamps[1])
+	index = s.m.AddSequencedInput(s.senders[2], s.payloads[2], s.timestamps[2])
+	s.Equal(2, index)
+
+	inputs := s.m.GetInputs(InputFilter{}, 0, 100)
+	s.Len(inputs, 3)
+	s.Equal(uint64(0), inputs[0].BlockNumber)
+	s.Equal(uint64(10), inputs[1].BlockNumber)
+	s.Equal(uint64(10), inputs[2].BlockNumber)
+	s.Equal(s.senders[2], inputs[2].MsgSender)
+	s.Equal(s.payloads[2], inputs[2].Payload)
+	s.Equal(s.timestamps[2], inputs[2].Timestamp)
+	s.Equal(CompletionStatusUnprocessed, inputs[2].Status)
+	s.Equal(1, s.m.GetNumInputBoxInputs())
+}
+
+//
+// RollbackInputBoxInputs
+//
+
+func (s *ModelSuite) TestItRollsBackInputBoxInputs() {
+	s.m.AddAdvanceInput(s.senders[0], s.payloads[0], 0, s.timestamps[0])
+	s.m.AddAdvanceInput(s.senders[1], s.payloads[1], 1, s.timestamps[1])
+	s.m.AddSequencedInput(s.senders[2], s.payloads[2], s.timestamps[2])
+	s.m.AddAdvanceInput(s.senders[2], []byte("orphaned"), 2, s.timestamps[2])
+	input, ok := s.m.FinishAndGetNext(true).(AdvanceInput)
+	s.True(ok)
+	s.Equal(0, input.Index)
+	_, err := s.m.AddNotice(s.payloads[0])
+	s.Nil(err)
+	s.m.FinishAndGetNext(true)
+
+	s.Equal(0, s.m.RollbackInputBoxInputs(3))
+	s.Equal(2, s.m.RollbackInputBoxInputs(1))
+	s.Equal(1, s.m.GetNumInputBoxInputs())
+
+	// the sequenced input is kept and all inputs are processed again
+	inputs := s.m.GetInputs(InputFilter{}, 0, 100)
+	s.Len(inputs, 2)
+	s.Equal(s.payloads[0], inputs[0].Payload)
+	s.Equal(s.payloads[2], inputs[1].Payload)
+	s.Equal(1, inputs[1].Index)
+	for _, input := range inputs {
+		s.Equal(CompletionStatusUnprocessed, input.Status)
+		s.Empty(input.Notices)
+	}
+	input, ok = s.m.FinishAndGetNext(true).(AdvanceInput)
+	s.True(ok)
+	s.Equal(0, input.Index)
+
+	// new inputs from the InputBox follow the kept ones
+	s.m.AddAdvanceInput(s.senders[1], s.payloads[1], 3, s.timestamps[1])
+	s.Equal(2, s.m.GetNumInputBoxInputs())
+}
+
+func (s *ModelSuite) TestItReleasesPendingInputs() {
+	now := time.Now()
+	for i := 0; i < s.n; i++ {
+		s.m.AddPendingInput(PendingInput{
+			MsgSender:    s.senders[i],
+			Payload:      s.payloads[i],
+			BlockNumber:  s.blockNumbers[i],
+			Timestamp:    s.timestamps[i],
+			ReleaseAt:    now.Add(time.Duration(i) * time.Second),
+			ReleaseBlock: uint64(i) + 1,
+		})
+	}
+	s.Equal(s.n, s.m.GetNumInputBoxInputs())
+	s.Equal(0, s.m.GetNumInputs(InputFilter{}))
+	s.Len(s.m.GetPendingInputs(), s.n)
+
+	// the inputs wait for both the time and the block
+	s.Equal(0, s.m.ReleasePendingInputs(now.Add(time.Hour), 0))
+	s.Equal(1, s.m.ReleasePendingInputs(now.Add(time.Second), 1))
+	s.Equal(1, s.m.ReleasePendingInputs(now.Add(time.Hour), 2))
+	s.Equal(2, s.m.GetNumInputs(InputFilter{}))
+	pending := s.m.GetPendingInputs()
+	s.Len(pending, 1)
+	s.Equal(s.payloads[2], pending[0].Payload)
+	input, ok := s.m.GetAdvanceInput(1)
+	s.True(ok)
+	s.Equal(s.payloads[1], input.Payload)
+	s.Equal(s.blockNumbers[1], input.BlockNumber)
+	s.Equal(s.timestamps[1], input.Timestamp)
+
+	// the pending inputs are orphaned first
+	s.Equal(1, s.m.RollbackInputBoxInputs(2))
+	s.Empty(s.m.GetPendingInputs())
+	s.Equal(2, s.m.GetNumInputs(InputFilter{}))
+	s.Equal(2, s.m.GetNumInputBoxInputs())
+}
+
 //
 // AddInspectInput and GetInspectInput
 //
@@ -264,6 +364,254 @@ func (s *ModelSuite) TestItComputesProcessedInputCount() {
 	s.Equal(s.n, input.ProccessedInputCount)
 }
 
+//
+// ResetCurrentInput and ResetAdvanceInputs
+//
+
+func (s *ModelSuite) TestItResetsCurrentInput() {
+	// add input and process it partially
+	s.m.AddAdvanceInput(s.senders[0], s.payloads[0], s.blockNumbers[0], s.timestamps[0])
+	s.m.FinishAndGetNext(true) // get
+	_, err := s.m.AddNotice(s.payloads[0])
+	s.Nil(err)
+	s.m.ResetCurrentInput()
+
+	// check the input is delivered again
+	input, ok := s.m.FinishAndGetNext(true).(AdvanceInput)
+	s.True(ok)
+	s.Equal(0, input.Index)
+	s.Equal(CompletionStatusUnprocessed, input.Status)
+	s.m.FinishAndGetNext(true) // finish
+
+	// check the partial outputs were discarded
+	notices := s.m.GetNotices(OutputFilter{}, 0, 100)
+	s.Empty(notices)
+}
+
+func (s *ModelSuite) TestItHaltsCurrentAdvance() {
+	// add input and process it partially
+	s.m.AddAdvanceInput(s.senders[0], s.payloads[0], s.blockNumbers[0], s.timestamps[0])
+	s.m.FinishAndGetNext(true) // get
+	_, err := s.m.AddNotice(s.payloads[0])
+	s.Nil(err)
+	err = s.m.AddReport(s.payloads[0])
+	s.Nil(err)
+	s.m.HaltCurrentInput(MachineHalt{ExitCode: 1, Stderr: "panic"})
+
+	// check input
+	input, ok := s.m.GetAdvanceInput(0)
+	s.True(ok)
+	s.Equal(CompletionStatusMachineHalted, input.Status)
+	s.Equal(&MachineHalt{ExitCode: 1, Stderr: "panic"}, input.MachineHalt)
+	s.Empty(input.Notices)
+	s.Empty(input.Reports)
+
+	// check the model is idle and the input is not delivered again
+	s.Nil(s.m.FinishAndGetNext(true))
+	s.Empty(s.m.GetNotices(OutputFilter{}, 0, 100))
+	s.Empty(s.m.GetReports(OutputFilter{}, 0, 100))
+}
+
+func (s *ModelSuite) TestItHaltsCurrentInspect() {
+	// add input and process it partially
+	s.m.AddInspectInput(s.payloads[0])
+	s.m.FinishAndGetNext(true) // get
+	err := s.m.AddReport(s.payloads[0])
+	s.Nil(err)
+	s.m.HaltCurrentInput(MachineHalt{ExitCode: -1})
+
+	// check input
+	input := s.m.GetInspectInput(0)
+	s.Equal(CompletionStatusMachineHalted, input.Status)
+	s.Equal(&MachineHalt{ExitCode: -1}, input.MachineHalt)
+	s.Empty(input.Reports)
+}
+
+func (s *ModelSuite) TestItIgnoresHaltWhenIdle() {
+	s.m.AddAdvanceInput(s.senders[0], s.payloads[0], s.blockNumbers[0], s.timestamps[0])
+	s.m.HaltCurrentInput(MachineHalt{ExitCode: 1})
+
+	// check the input is still delivered
+	input, ok := s.m.FinishAndGetNext(true).(AdvanceInput)
+	s.True(ok)
+	s.Equal(CompletionStatusUnprocessed, input.Status)
+	s.Nil(input.MachineHalt)
+}
+
+func (s *ModelSuite) TestItNotifiesObservers() {
+	observer := &testObserver{}
+	s.m.AddObserver(observer)
+	s.m.AddAdvanceInput(s.senders[0], s.payloads[0], s.blockNumbers[0], s.timestamps[0])
+	s.m.AddAdvanceInput(s.senders[1], s.payloads[1], s.blockNumbers[1], s.timestamps[1])
+	s.m.AddInspectInput(s.payloads[2])
+	s.m.FinishAndGetNext(true)  // get inspect
+	s.m.FinishAndGetNext(true)  // finish inspect and get advance 0
+	s.m.FinishAndGetNext(false) // finish advance 0 and get advance 1
+	s.m.HaltCurrentInput(MachineHalt{ExitCode: 1})
+
+	s.Equal([]string{
+		"started inspect 0",
+		"finished inspect 0",
+		"started advance 0",
+		"finished advance 0",
+		"started advance 1",
+		"finished advance 1",
+	}, observer.events)
+	s.Equal([]CompletionStatus{
+		CompletionStatusAccepted,
+		CompletionStatusRejected,
+		CompletionStatusMachineHalted,
+	}, observer.statuses)
+}
+
+func (s *ModelSuite) TestItRecordsUsage() {
+	meter := &testMeter{resources: ResourceUsage{CPUTime: time.Second, PeakMemory: 1024}}
+	s.m.SetUsageMeter(meter)
+	s.m.AddAdvanceInput(s.senders[0], s.payloads[0], s.blockNumbers[0], s.timestamps[0])
+	s.m.AddInspectInput(s.payloads[1])
+	before := time.Now()
+	s.m.FinishAndGetNext(true) // get inspect
+	s.m.FinishAndGetNext(true) // finish inspect and get advance
+	err := s.m.RegisterException(s.payloads[0])
+	s.Nil(err)
+	after := time.Now()
+
+	advance, ok := s.m.GetAdvanceInput(0)
+	s.True(ok)
+	inspect := s.m.GetInspectInput(0)
+	for _, usage := range []*InputUsage{advance.Usage, inspect.Usage} {
+		s.NotNil(usage)
+		s.False(usage.StartedAt.Before(before))
+		s.False(usage.FinishedAt.Before(usage.StartedAt))
+		s.False(after.Before(usage.FinishedAt))
+		s.Equal(&meter.resources, usage.Resources)
+	}
+	s.Equal(2, meter.starts)
+	s.Equal(2, meter.stops)
+}
+
+func (s *ModelSuite) TestItRecordsUsageWithoutMeter() {
+	s.m.AddAdvanceInput(s.senders[0], s.payloads[0], s.blockNumbers[0], s.timestamps[0])
+	s.m.FinishAndGetNext(true) // get
+	s.m.FinishAndGetNext(true) // finish
+
+	input, ok := s.m.GetAdvanceInput(0)
+	s.True(ok)
+	s.NotNil(input.Usage)
+	s.Nil(input.Usage.Resources)
+}
+
+func (s *ModelSuite) TestItAddsLogsToCurrentInput() {
+	s.m.AddAdvanceInput(s.senders[0], s.payloads[0], s.blockNumbers[0], s.timestamps[0])
+	s.m.AddAdvanceInput(s.senders[1], s.payloads[1], s.blockNumbers[1], s.timestamps[1])
+	s.m.AddLog("stdout", "idle") // discarded
+	s.m.FinishAndGetNext(true)   // get
+	s.m.AddLog("stdout", "first")
+	s.m.AddLog("stderr", "second")
+	s.m.FinishAndGetNext(true) // finish and get next
+	s.m.AddLog("stderr", "panic")
+	s.m.HaltCurrentInput(MachineHalt{ExitCode: 1})
+
+	input, ok := s.m.GetAdvanceInput(0)
+	s.True(ok)
+	s.Len(input.Logs, 2)
+	s.Equal("stdout", input.Logs[0].Stream)
+	s.Equal("first", input.Logs[0].Line)
+	s.Equal("stderr", input.Logs[1].Stream)
+	s.Equal("second", input.Logs[1].Line)
+
+	// logs are kept when the machine halts
+	input, ok = s.m.GetAdvanceInput(1)
+	s.True(ok)
+	s.Len(input.Logs, 1)
+	s.Equal("panic", input.Logs[0].Line)
+}
+
+func (s *ModelSuite) TestItSyncsLogsBeforeFinishingInput() {
+	s.m.AddAdvanceInput(s.senders[0], s.payloads[0], s.blockNumbers[0], s.timestamps[0])
+	s.m.AddAdvanceInput(s.senders[1], s.payloads[1], s.blockNumbers[1], s.timestamps[1])
+	s.m.FinishAndGetNext(true) // get
+	s.m.SetLogSync(func() {
+		s.m.AddLog("stdout", "late")
+	})
+	s.m.FinishAndGetNext(true) // finish and get next
+	s.Nil(s.m.RegisterException([]byte("exception")))
+
+	input, ok := s.m.GetAdvanceInput(0)
+	s.True(ok)
+	s.Len(input.Logs, 1)
+	s.Equal("late", input.Logs[0].Line)
+
+	input, ok = s.m.GetAdvanceInput(1)
+	s.True(ok)
+	s.Len(input.Logs, 1)
+	s.Equal("late", input.Logs[0].Line)
+}
+
+func (s *ModelSuite) TestItLimitsLogs() {
+	s.m.AddInspectInput(s.payloads[0])
+	s.m.FinishAndGetNext(true) // get
+	for i := 0; i < InputLogLimit+1; i++ {
+		s.m.AddLog("stdout", fmt.Sprint(i))
+	}
+	s.m.FinishAndGetNext(true) // finish
+
+	input := s.m.GetInspectInput(0)
+	s.Len(input.Logs, InputLogLimit)
+	s.Equal("1", input.Logs[0].Line)
+	s.Equal(fmt.Sprint(InputLogLimit), input.Logs[InputLogLimit-1].Line)
+}
+
+func (s *ModelSuite) TestItAddsViolations() {
+	s.m.AddAdvanceInput(s.senders[0], s.payloads[0], s.blockNumbers[0], s.timestamps[0])
+	s.m.AddInspectInput(s.payloads[1])
+	s.m.AddViolation("idle")
+	s.Nil(s.m.GetCurrentInput())
+	s.m.FinishAndGetNext(true) // get inspect
+	s.IsType(InspectInput{}, s.m.GetCurrentInput())
+	s.m.AddViolation("inspect")
+	s.m.FinishAndGetNext(true) // finish inspect and get advance
+	s.m.AddViolation("advance")
+	s.m.FinishAndGetNext(false) // reject advance
+
+	// violations are kept when the input is rejected
+	input, ok := s.m.GetAdvanceInput(0)
+	s.True(ok)
+	s.Equal([]string{"advance"}, input.Violations)
+	s.Equal([]string{"inspect"}, s.m.GetInspectInput(0).Violations)
+	violations := s.m.GetViolations()
+	s.Equal(map[int][]string{0: {"advance"}}, violations.Advances)
+	s.Equal(map[int][]string{0: {"inspect"}}, violations.Inspects)
+	s.Equal([]string{"idle"}, violations.Idle)
+}
+
+func (s *ModelSuite) TestItResetsAdvanceInputs() {
+	// process n advance inputs
+	for i := 0; i < s.n; i++ {
+		s.m.AddAdvanceInput(s.senders[i], s.payloads[i], s.blockNumbers[i], s.timestamps[i])
+		s.m.FinishAndGetNext(true) // get
+		_, err := s.m.AddNotice(s.payloads[i])
+		s.Nil(err)
+		s.m.FinishAndGetNext(true) // finish
+	}
+	s.m.ResetAdvanceInputs()
+
+	// check inputs
+	inputs := s.m.GetInputs(InputFilter{}, 0, 100)
+	s.Len(inputs, s.n)
+	for _, input := range inputs {
+		s.Equal(CompletionStatusUnprocessed, input.Status)
+		s.Empty(input.Notices)
+	}
+
+	// check the inputs are delivered again
+	for i := 0; i < s.n; i++ {
+		input, ok := s.m.FinishAndGetNext(true).(AdvanceInput)
+		s.True(ok)
+		s.Equal(i, input.Index)
+	}
+}
+
 //
 // AddVoucher
 //
@@ -1123,3 +1471,54 @@ func (s *ModelSuite) TestItGetsNoReportsWhenOffsetIsGreaterThanInputs() {
 	reports := s.m.GetReports(OutputFilter{}, 0, 0)
 	s.Empty(reports)
 }
+
+// Meter that returns fixed resources.
+type testMeter struct {
+	resources ResourceUsage
+	starts    int
+	stops     int
+}
+
+func (m *testMeter) Sample() (UsageSample, bool) {
+	return UsageSample{}, true
+}
+
+func (m *testMeter) Start(sample *UsageSample) {
+	m.starts++
+}
+
+func (m *testMeter) Stop(sample *UsageSample) (ResourceUsage, bool) {
+	m.stops++
+	return m.resources, true
+}
+
+// Observer that records the input events.
+type testObserver struct {
+	events   []string
+	statuses []CompletionStatus
+}
+
+func (o *testObserver) InputStarted(input Input) {
+	o.events = append(o.events, "started "+o.describe(input))
+}
+
+func (o *testObserver) InputFinished(input Input) {
+	o.events = append(o.events, "finished "+o.describe(input))
+	switch input := input.(type) {
+	case AdvanceInput:
+		o.statuses = append(o.statuses, input.Status)
+	case InspectInput:
+		o.statuses = append(o.statuses, input.Status)
+	}
+}
+
+func (o *testObserver) describe(input Input) string {
+	switch input := input.(type) {
+	case AdvanceInput:
+		return fmt.Sprintf("advance %v", input.Index)
+	case InspectInput:
+		return fmt.Sprintf("inspect %v", input.Index)
+	default:
+		return "unknown"
+	}
+}
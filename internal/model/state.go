@@ -28,6 +28,21 @@ type rollupsState interface {
 
 	// Register exception in current state.
 	registerException(payload []byte) error
+
+	// Finish the current state because the application exited, discarding the outputs.
+	halt(info MachineHalt)
+
+	// Get a copy of the input being processed; nil if there is none.
+	current() Input
+
+	// Set the usage of the input being processed.
+	setUsage(usage InputUsage)
+
+	// Add a log line to current state.
+	addLog(log Log)
+
+	// Add a conformance violation to the current input.
+	addViolation(violation string)
 }
 
 //
@@ -61,16 +76,38 @@ func (s *rollupsStateIdle) registerException(payload []byte) error {
 	return fmt.Errorf("cannot register exception in current state")
 }
 
+func (s *rollupsStateIdle) halt(info MachineHalt) {
+	// Do nothing
+}
+
+func (s *rollupsStateIdle) current() Input {
+	return nil
+}
+
+func (s *rollupsStateIdle) setUsage(usage InputUsage) {
+	// Do nothing
+}
+
+func (s *rollupsStateIdle) addLog(log Log) {
+	// Do nothing
+}
+
+func (s *rollupsStateIdle) addViolation(violation string) {
+	// Do nothing
+}
+
 //
 // Advance
 //
 
 // In the advance state, the model accumulates the outputs from an advance.
 type rollupsStateAdvance struct {
-	input    *AdvanceInput
-	vouchers []Voucher
-	notices  []Notice
-	reports  []Report
+	input      *AdvanceInput
+	vouchers   []Voucher
+	notices    []Notice
+	reports    []Report
+	logs       []Log
+	violations []string
 }
 
 func newRollupsStateAdvance(input *AdvanceInput) *rollupsStateAdvance {
@@ -87,6 +124,8 @@ func (s *rollupsStateAdvance) finish(status CompletionStatus) {
 		s.input.Notices = s.notices
 	}
 	s.input.Reports = s.reports
+	s.input.Logs = s.logs
+	s.input.Violations = s.violations
 	slog.Info("nonodo: finished advance")
 }
 
@@ -132,10 +171,38 @@ func (s *rollupsStateAdvance) registerException(payload []byte) error {
 	s.input.Status = CompletionStatusException
 	s.input.Reports = s.reports
 	s.input.Exception = payload
+	s.input.Logs = s.logs
+	s.input.Violations = s.violations
 	slog.Info("nonodo: finished advance with exception")
 	return nil
 }
 
+func (s *rollupsStateAdvance) halt(info MachineHalt) {
+	s.input.Status = CompletionStatusMachineHalted
+	s.input.MachineHalt = &info
+	s.input.Logs = s.logs
+	s.input.Violations = s.violations
+	slog.Warn("nonodo: machine halted during advance", "index", s.input.Index,
+		"exitCode", info.ExitCode)
+}
+
+func (s *rollupsStateAdvance) current() Input {
+	return *s.input
+}
+
+func (s *rollupsStateAdvance) setUsage(usage InputUsage) {
+	s.input.Usage = &usage
+	logUsage("nonodo: advance usage", s.input.Index, usage)
+}
+
+func (s *rollupsStateAdvance) addLog(log Log) {
+	s.logs = appendLog(s.logs, log)
+}
+
+func (s *rollupsStateAdvance) addViolation(violation string) {
+	s.violations = append(s.violations, violation)
+}
+
 //
 // Inspect
 //
@@ -144,6 +211,8 @@ func (s *rollupsStateAdvance) registerException(payload []byte) error {
 type rollupsStateInspect struct {
 	input                   *InspectInput
 	reports                 []Report
+	logs                    []Log
+	violations              []string
 	getProccessedInputCount func() int
 }
 
@@ -162,6 +231,8 @@ func (s *rollupsStateInspect) finish(status CompletionStatus) {
 	s.input.Status = status
 	s.input.ProccessedInputCount = s.getProccessedInputCount()
 	s.input.Reports = s.reports
+	s.input.Logs = s.logs
+	s.input.Violations = s.violations
 	slog.Info("nonodo: finished inspect")
 }
 
@@ -190,6 +261,57 @@ func (s *rollupsStateInspect) registerException(payload []byte) error {
 	s.input.ProccessedInputCount = s.getProccessedInputCount()
 	s.input.Reports = s.reports
 	s.input.Exception = payload
+	s.input.Logs = s.logs
+	s.input.Violations = s.violations
 	slog.Info("nonodo: finished inspect with exception")
 	return nil
 }
+
+func (s *rollupsStateInspect) halt(info MachineHalt) {
+	s.input.Status = CompletionStatusMachineHalted
+	s.input.ProccessedInputCount = s.getProccessedInputCount()
+	s.input.MachineHalt = &info
+	s.input.Logs = s.logs
+	s.input.Violations = s.violations
+	slog.Warn("nonodo: machine halted during inspect", "index", s.input.Index,
+		"exitCode", info.ExitCode)
+}
+
+func (s *rollupsStateInspect) current() Input {
+	return *s.input
+}
+
+func (s *rollupsStateInspect) setUsage(usage InputUsage) {
+	s.input.Usage = &usage
+	logUsage("nonodo: inspect usage", s.input.Index, usage)
+}
+
+func (s *rollupsStateInspect) addLog(log Log) {
+	s.logs = appendLog(s.logs, log)
+}
+
+func (s *rollupsStateInspect) addViolation(violation string) {
+	s.violations = append(s.violations, violation)
+}
+
+//
+// Auxiliary Functions
+//
+
+// Log the usage of the input if nonodo measured its resources.
+func logUsage(msg string, index int, usage InputUsage) {
+	if usage.Resources == nil {
+		return
+	}
+	slog.Info(msg, "index", index, "duration", usage.FinishedAt.Sub(usage.StartedAt),
+		"cpuTime", usage.Resources.CPUTime, "peakMemory", usage.Resources.PeakMemory,
+		"readBytes", usage.Resources.ReadBytes, "writeBytes", usage.Resources.WriteBytes)
+}
+
+// Append the log line, dropping the oldest line if the logs reached the limit.
+func appendLog(logs []Log, log Log) []Log {
+	if len(logs) == InputLogLimit {
+		logs = logs[1:]
+	}
+	return append(logs, log)
+}
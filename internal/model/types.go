@@ -4,6 +4,7 @@
 package model
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -51,8 +52,86 @@ const (
 	CompletionStatusAccepted
 	CompletionStatusRejected
 	CompletionStatusException
+	CompletionStatusMachineHalted
 )
 
+func (s CompletionStatus) String() string {
+	switch s {
+	case CompletionStatusUnprocessed:
+		return "UNPROCESSED"
+	case CompletionStatusAccepted:
+		return "ACCEPTED"
+	case CompletionStatusRejected:
+		return "REJECTED"
+	case CompletionStatusException:
+		return "EXCEPTION"
+	case CompletionStatusMachineHalted:
+		return "MACHINE_HALTED"
+	default:
+		return fmt.Sprintf("CompletionStatus(%d)", int(s))
+	}
+}
+
+// Information about the application exit that halted the machine.
+type MachineHalt struct {
+	// Exit code of the application; -1 if it was terminated by a signal.
+	ExitCode int
+
+	// Last lines the application wrote to stderr.
+	Stderr string
+
+	// Reason nonodo stopped the application if it exceeded a resource limit; empty otherwise.
+	Reason string
+}
+
+// Maximum number of log lines stored for each input; nonodo keeps the last lines.
+const InputLogLimit = 1000
+
+// Line the application wrote to stdout or stderr while processing an input.
+type Log struct {
+	Stream    string
+	Line      string
+	Timestamp time.Time
+}
+
+// Resources used by the application to process an input.
+type ResourceUsage struct {
+	// CPU time used by the application processes.
+	CPUTime time.Duration
+
+	// Peak resident memory of the application processes in bytes.
+	PeakMemory int64
+
+	// Bytes the application processes read from and wrote to the storage.
+	ReadBytes  int64
+	WriteBytes int64
+}
+
+// Resources used by the application processes since they started.
+type UsageSample struct {
+	// CPU time used by the application processes.
+	CPUTime time.Duration
+
+	// Resident memory of the application processes in bytes.
+	Memory int64
+
+	// Bytes the application processes read from and wrote to the storage.
+	ReadBytes  int64
+	WriteBytes int64
+}
+
+// Information about how the application processed an input.
+type InputUsage struct {
+	// Time when the application received the input.
+	StartedAt time.Time
+
+	// Time when the application finished processing the input.
+	FinishedAt time.Time
+
+	// Resources used by the application; nil if nonodo didn't measure them.
+	Resources *ResourceUsage
+}
+
 // Rollups input, which can be advance or inspect.
 type Input interface{}
 
@@ -68,6 +147,23 @@ type AdvanceInput struct {
 	Notices     []Notice
 	Reports     []Report
 	Exception   []byte
+	MachineHalt *MachineHalt
+	Usage       *InputUsage
+	Logs        []Log
+	Violations  []string
+}
+
+// Advance input from the InputBox that the model holds before adding it to the advance inputs,
+// emulating the time an input takes to arrive in a test net.
+type PendingInput struct {
+	MsgSender   common.Address
+	Payload     []byte
+	BlockNumber uint64
+	Timestamp   time.Time
+
+	// The input is released after this time and after the chain reaches this block.
+	ReleaseAt    time.Time
+	ReleaseBlock uint64
 }
 
 // Rollups inspect input type.
@@ -78,4 +174,18 @@ type InspectInput struct {
 	ProccessedInputCount int
 	Reports              []Report
 	Exception            []byte
+	MachineHalt          *MachineHalt
+	Usage                *InputUsage
+	Logs                 []Log
+	Violations           []string
+}
+
+// Conformance violations of the rollup API found in strict mode.
+type Violations struct {
+	// Violations of each advance and inspect input, indexed by the input index.
+	Advances map[int][]string
+	Inspects map[int][]string
+
+	// Violations in requests made while the application wasn't processing an input.
+	Idle []string
 }
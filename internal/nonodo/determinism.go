@@ -0,0 +1,200 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package nonodo
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/supervisor"
+	"github.com/labstack/echo/v4"
+)
+
+// Compares the results of the application and its replica for each advance input.
+// The replica has its own model, which receives each advance input when the application starts
+// processing it.
+type determinismChecker struct {
+	replica *model.NonodoModel
+
+	mutex sync.Mutex
+
+	// Number of inputs sent to the replica.
+	sent int
+
+	// Finished inputs that wait for the other side, indexed by side and input index.
+	pending [2]map[int]model.AdvanceInput
+}
+
+// Sides of the determinism checker.
+const (
+	determinismApp = iota
+	determinismReplica
+)
+
+// Observer of one side of the determinism checker.
+type determinismObserver struct {
+	checker *determinismChecker
+	side    int
+}
+
+func newDeterminismChecker(replica *model.NonodoModel) *determinismChecker {
+	return &determinismChecker{
+		replica: replica,
+		pending: [2]map[int]model.AdvanceInput{
+			make(map[int]model.AdvanceInput),
+			make(map[int]model.AdvanceInput),
+		},
+	}
+}
+
+// Get the observer for the application model.
+func (c *determinismChecker) appObserver() model.InputObserver {
+	return determinismObserver{c, determinismApp}
+}
+
+// Get the observer for the replica model.
+func (c *determinismChecker) replicaObserver() model.InputObserver {
+	return determinismObserver{c, determinismReplica}
+}
+
+func (o determinismObserver) InputStarted(input model.Input) {
+	advance, ok := input.(model.AdvanceInput)
+	if !ok || o.side != determinismApp {
+		return
+	}
+	// the application might start the same input again after restarting
+	if !o.checker.send(advance.Index) {
+		return
+	}
+	o.checker.replica.AddAdvanceInput(advance.MsgSender, advance.Payload,
+		advance.BlockNumber, advance.Timestamp)
+}
+
+func (o determinismObserver) InputFinished(input model.Input) {
+	advance, ok := input.(model.AdvanceInput)
+	if !ok {
+		return
+	}
+	o.checker.finished(o.side, advance)
+}
+
+// Count the input as sent to the replica; return false if it was already sent.
+// The replica model calls the checker while holding its lock, so the caller must send the input
+// to the replica after this method returns.
+func (c *determinismChecker) send(index int) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if index < c.sent {
+		return false
+	}
+	c.sent++
+	return true
+}
+
+// Remove the advances from the replica after the application rolled back its inputs.
+// The replica must be stopped; it receives the remaining advances again as the application
+// processes them from scratch.
+func (c *determinismChecker) reset() {
+	c.mutex.Lock()
+	c.sent = 0
+	for side := range c.pending {
+		c.pending[side] = make(map[int]model.AdvanceInput)
+	}
+	c.mutex.Unlock()
+
+	c.replica.RollbackInputBoxInputs(0)
+}
+
+// Store the finished input and compare it with the other side if it finished too.
+func (c *determinismChecker) finished(side int, input model.AdvanceInput) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	other, ok := c.pending[1-side][input.Index]
+	if !ok {
+		c.pending[side][input.Index] = input
+		return
+	}
+	delete(c.pending[1-side], input.Index)
+	app, replica := input, other
+	if side == determinismReplica {
+		app, replica = other, input
+	}
+	if divergence := compareAdvances(app, replica); divergence != "" {
+		slog.Error("nonodo: NONDETERMINISM DETECTED; the application and its replica diverged",
+			"index", input.Index, "divergence", divergence)
+	} else {
+		slog.Debug("nonodo: replica matched the application", "index", input.Index)
+	}
+}
+
+// Compare the results of two advances.
+// Return the description of the first divergence, or an empty string if they match.
+func compareAdvances(app model.AdvanceInput, replica model.AdvanceInput) string {
+	if app.Status != replica.Status {
+		return fmt.Sprintf("status: %v != %v", app.Status, replica.Status)
+	}
+	if len(app.Vouchers) != len(replica.Vouchers) {
+		return fmt.Sprintf("voucher count: %v != %v", len(app.Vouchers), len(replica.Vouchers))
+	}
+	for i := range app.Vouchers {
+		if app.Vouchers[i].Destination != replica.Vouchers[i].Destination {
+			return fmt.Sprintf("voucher %v destination: %v != %v", i,
+				app.Vouchers[i].Destination, replica.Vouchers[i].Destination)
+		}
+		divergence := comparePayloads(app.Vouchers[i].Payload, replica.Vouchers[i].Payload)
+		if divergence != "" {
+			return fmt.Sprintf("voucher %v payload: %v", i, divergence)
+		}
+	}
+	if len(app.Notices) != len(replica.Notices) {
+		return fmt.Sprintf("notice count: %v != %v", len(app.Notices), len(replica.Notices))
+	}
+	for i := range app.Notices {
+		divergence := comparePayloads(app.Notices[i].Payload, replica.Notices[i].Payload)
+		if divergence != "" {
+			return fmt.Sprintf("notice %v payload: %v", i, divergence)
+		}
+	}
+	if len(app.Reports) != len(replica.Reports) {
+		return fmt.Sprintf("report count: %v != %v", len(app.Reports), len(replica.Reports))
+	}
+	for i := range app.Reports {
+		divergence := comparePayloads(app.Reports[i].Payload, replica.Reports[i].Payload)
+		if divergence != "" {
+			return fmt.Sprintf("report %v payload: %v", i, divergence)
+		}
+	}
+	if divergence := comparePayloads(app.Exception, replica.Exception); divergence != "" {
+		return fmt.Sprintf("exception: %v", divergence)
+	}
+	return ""
+}
+
+// Compare two payloads, returning the description of the divergence if they differ.
+func comparePayloads(app []byte, replica []byte) string {
+	if bytes.Equal(app, replica) {
+		return ""
+	}
+	return fmt.Sprintf("%v != %v", hexutil.Encode(app), hexutil.Encode(replica))
+}
+
+// Create the worker of the replica that checks the determinism of the application.
+func newDeterminismReplica(
+	opts NonodoOpts,
+	e *echo.Echo,
+	m *model.NonodoModel,
+	app supervisor.CommandWorker,
+) (supervisor.Worker, *determinismChecker) {
+	replica, replicaModel := newReplica(opts, e, app, "determinism")
+	checker := newDeterminismChecker(replicaModel)
+	m.AddObserver(checker.appObserver())
+	replicaModel.AddObserver(checker.replicaObserver())
+	return replica, checker
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package nonodo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeterminismCheckerFeedsReplica(t *testing.T) {
+	app := model.NewNonodoModel()
+	replica := model.NewNonodoModel()
+	checker := newDeterminismChecker(replica)
+	app.AddObserver(checker.appObserver())
+	replica.AddObserver(checker.replicaObserver())
+
+	sender := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	app.AddAdvanceInput(sender, []byte("hi"), 1, time.Unix(1, 0))
+	assert.Nil(t, replica.FinishAndGetNext(true))
+
+	// the replica receives the input when the application starts it
+	appInput := app.FinishAndGetNext(true)
+	replicaInput := replica.FinishAndGetNext(true)
+	assert.Equal(t, appInput, replicaInput)
+
+	// restarting the application doesn't send the input again
+	app.ResetCurrentInput()
+	app.FinishAndGetNext(true)
+	_, ok := replica.GetAdvanceInput(1)
+	assert.False(t, ok)
+
+	// both finish the input
+	_, err := app.AddNotice([]byte("notice"))
+	assert.Nil(t, err)
+	_, err = replica.AddNotice([]byte("notice"))
+	assert.Nil(t, err)
+	app.FinishAndGetNext(true)
+	replica.FinishAndGetNext(true)
+	assert.Empty(t, checker.pending[determinismApp])
+	assert.Empty(t, checker.pending[determinismReplica])
+}
+
+func TestCompareAdvances(t *testing.T) {
+	base := model.AdvanceInput{
+		Status:   model.CompletionStatusAccepted,
+		Vouchers: []model.Voucher{{Destination: common.Address{1}, Payload: []byte{1}}},
+		Notices:  []model.Notice{{Payload: []byte{2}}},
+		Reports:  []model.Report{{Payload: []byte{3}}},
+	}
+	assert.Equal(t, "", compareAdvances(base, base))
+
+	other := base
+	other.Status = model.CompletionStatusRejected
+	assert.Equal(t, "status: ACCEPTED != REJECTED", compareAdvances(base, other))
+
+	other = base
+	other.Vouchers = nil
+	assert.Equal(t, "voucher count: 1 != 0", compareAdvances(base, other))
+
+	other = base
+	other.Notices = []model.Notice{{Payload: []byte{4}}}
+	assert.Equal(t, "notice 0 payload: 0x02 != 0x04", compareAdvances(base, other))
+
+	other = base
+	other.Reports = []model.Report{{Payload: []byte{3}}, {Payload: []byte{5}}}
+	assert.Equal(t, "report count: 1 != 2", compareAdvances(base, other))
+}
@@ -0,0 +1,195 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package nonodo
+
+import (
+	"sync"
+
+	"github.com/gligneul/nonodo/internal/model"
+)
+
+// Routes inspects to a pool of application replicas, so they don't wait for the advances.
+// Each replica receives the accepted advances from the application and serves inspects once
+// it processed all of them. If no replica is ready, the application serves the inspect.
+// The pool implements the inspect model interface.
+type inspectPool struct {
+	app *model.NonodoModel
+
+	mutex    sync.Mutex
+	replicas []*inspectReplica
+	routes   []inspectRoute
+
+	// Number of advances processed by the application.
+	processed int
+
+	// Replica checked first in the next inspect, so the ties are distributed among replicas.
+	next int
+}
+
+// State of a replica in the inspect pool.
+type inspectReplica struct {
+	model *model.NonodoModel
+
+	// Number of advances sent to the replica and number of advances it finished.
+	sent     int
+	finished int
+
+	// Number of inspects sent to the replica that it didn't finish.
+	pending int
+}
+
+// Location of an inspect in the pool.
+type inspectRoute struct {
+	model *model.NonodoModel
+	index int
+
+	// Processed input count when the inspect was sent to the replica; -1 for the application.
+	processed int
+}
+
+// Observer of the application for the inspect pool.
+type inspectPoolObserver struct {
+	pool *inspectPool
+}
+
+// Observer of a replica for the inspect pool.
+type inspectReplicaObserver struct {
+	pool    *inspectPool
+	replica *inspectReplica
+}
+
+func newInspectPool(app *model.NonodoModel) *inspectPool {
+	pool := &inspectPool{app: app}
+	app.AddObserver(inspectPoolObserver{pool})
+	return pool
+}
+
+// Add a replica to the pool.
+// This should be called before the application receives any input.
+func (p *inspectPool) addReplica(replicaModel *model.NonodoModel) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	replica := &inspectReplica{model: replicaModel}
+	p.replicas = append(p.replicas, replica)
+	replicaModel.AddObserver(inspectReplicaObserver{p, replica})
+}
+
+// Remove the advances from the replicas after the application rolled back its inputs.
+// The replicas must be stopped; they receive the remaining advances again as the application
+// processes them from scratch.
+func (p *inspectPool) reset() {
+	p.mutex.Lock()
+	p.processed = 0
+	replicas := p.replicas
+	for _, replica := range replicas {
+		replica.sent = 0
+		replica.finished = 0
+	}
+	p.mutex.Unlock()
+
+	for _, replica := range replicas {
+		replica.model.RollbackInputBoxInputs(0)
+	}
+}
+
+// Add the inspect to the least busy replica that processed all advances.
+// Return the inspect index in the pool.
+func (p *inspectPool) AddInspectInput(payload []byte) int {
+	// the replica models call the pool while holding their locks, so the pool must not hold its
+	// lock while calling them
+	p.mutex.Lock()
+	target := p.selectReplica()
+	route := inspectRoute{model: p.app, processed: -1}
+	if target != nil {
+		target.pending++
+		route.model = target.model
+		route.processed = p.processed
+	}
+	p.mutex.Unlock()
+
+	route.index = route.model.AddInspectInput(payload)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.routes = append(p.routes, route)
+	return len(p.routes) - 1
+}
+
+// Get the inspect given its index in the pool.
+func (p *inspectPool) GetInspectInput(index int) model.InspectInput {
+	p.mutex.Lock()
+	route := p.routes[index]
+	p.mutex.Unlock()
+
+	input := route.model.GetInspectInput(route.index)
+	input.Index = index
+	if route.processed >= 0 {
+		// the replica doesn't receive the rejected advances, so its count is different
+		input.ProccessedInputCount = route.processed
+	}
+	return input
+}
+
+// Select the replica with the fewest pending inspects among the ones that processed all
+// advances. Return nil if there is none.
+func (p *inspectPool) selectReplica() *inspectReplica {
+	var selected *inspectReplica
+	for i := range p.replicas {
+		replica := p.replicas[(p.next+i)%len(p.replicas)]
+		if replica.finished < replica.sent {
+			continue
+		}
+		if selected == nil || replica.pending < selected.pending {
+			selected = replica
+		}
+	}
+	if len(p.replicas) > 0 {
+		p.next = (p.next + 1) % len(p.replicas)
+	}
+	return selected
+}
+
+func (o inspectPoolObserver) InputStarted(input model.Input) {
+	// Do nothing
+}
+
+func (o inspectPoolObserver) InputFinished(input model.Input) {
+	advance, ok := input.(model.AdvanceInput)
+	if !ok {
+		return
+	}
+	o.pool.mutex.Lock()
+	o.pool.processed = advance.Index + 1
+	var replicas []*inspectReplica
+	if advance.Status == model.CompletionStatusAccepted {
+		// the replicas don't receive the other advances because the machine would revert them
+		replicas = o.pool.replicas
+		for _, replica := range replicas {
+			replica.sent++
+		}
+	}
+	o.pool.mutex.Unlock()
+
+	for _, replica := range replicas {
+		replica.model.AddAdvanceInput(advance.MsgSender, advance.Payload,
+			advance.BlockNumber, advance.Timestamp)
+	}
+}
+
+func (o inspectReplicaObserver) InputStarted(input model.Input) {
+	// Do nothing
+}
+
+func (o inspectReplicaObserver) InputFinished(input model.Input) {
+	o.pool.mutex.Lock()
+	defer o.pool.mutex.Unlock()
+
+	switch input.(type) {
+	case model.AdvanceInput:
+		o.replica.finished++
+	case model.InspectInput:
+		o.replica.pending--
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package nonodo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInspectPoolBalancesReplicas(t *testing.T) {
+	app := model.NewNonodoModel()
+	replicas := []*model.NonodoModel{model.NewNonodoModel(), model.NewNonodoModel()}
+	pool := newInspectPool(app)
+	for _, replica := range replicas {
+		pool.addReplica(replica)
+	}
+
+	// each replica receives one inspect
+	assert.Equal(t, 0, pool.AddInspectInput([]byte("a")))
+	assert.Equal(t, 1, pool.AddInspectInput([]byte("b")))
+	for _, replica := range replicas {
+		input, ok := replica.FinishAndGetNext(true).(model.InspectInput)
+		assert.True(t, ok)
+		assert.Contains(t, []string{"a", "b"}, string(input.Payload))
+		assert.Nil(t, replica.FinishAndGetNext(true))
+	}
+	for i := 0; i < 2; i++ {
+		input := pool.GetInspectInput(i)
+		assert.Equal(t, i, input.Index)
+		assert.Equal(t, model.CompletionStatusAccepted, input.Status)
+		assert.Equal(t, 0, input.ProccessedInputCount)
+	}
+	assert.Nil(t, app.FinishAndGetNext(true))
+}
+
+func TestInspectPoolWaitsForReplicasToCatchUp(t *testing.T) {
+	app := model.NewNonodoModel()
+	replica := model.NewNonodoModel()
+	pool := newInspectPool(app)
+	pool.addReplica(replica)
+
+	// the application accepts an advance, so the replica is behind
+	app.AddAdvanceInput(common.Address{}, []byte("accepted"), 0, time.Now())
+	app.FinishAndGetNext(true) // get
+	app.FinishAndGetNext(true) // accept
+	index := pool.AddInspectInput([]byte("inspect"))
+	input, ok := app.FinishAndGetNext(true).(model.InspectInput)
+	assert.True(t, ok)
+	assert.Equal(t, "inspect", string(input.Payload))
+	app.FinishAndGetNext(true) // finish
+	assert.Equal(t, 1, pool.GetInspectInput(index).ProccessedInputCount)
+
+	// the replica processes the advance and catches up
+	advance, ok := replica.FinishAndGetNext(true).(model.AdvanceInput)
+	assert.True(t, ok)
+	assert.Equal(t, "accepted", string(advance.Payload))
+	replica.FinishAndGetNext(true) // accept
+
+	// the replica doesn't receive rejected advances
+	app.AddAdvanceInput(common.Address{}, []byte("rejected"), 0, time.Now())
+	app.FinishAndGetNext(true)  // get
+	app.FinishAndGetNext(false) // reject
+	index = pool.AddInspectInput([]byte("inspect"))
+	input, ok = replica.FinishAndGetNext(true).(model.InspectInput)
+	assert.True(t, ok)
+	assert.Equal(t, "inspect", string(input.Payload))
+	replica.FinishAndGetNext(true) // finish
+	assert.Equal(t, model.CompletionStatusAccepted, pool.GetInspectInput(index).Status)
+	assert.Equal(t, 2, pool.GetInspectInput(index).ProccessedInputCount)
+}
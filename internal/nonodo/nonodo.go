@@ -7,6 +7,7 @@ package nonodo
 
 import (
 	"fmt"
+	"net"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -14,9 +15,13 @@ import (
 	"github.com/gligneul/nonodo/internal/echoapp"
 	"github.com/gligneul/nonodo/internal/inputter"
 	"github.com/gligneul/nonodo/internal/inspect"
+	"github.com/gligneul/nonodo/internal/libcmt"
+	"github.com/gligneul/nonodo/internal/mockapp"
 	"github.com/gligneul/nonodo/internal/model"
 	"github.com/gligneul/nonodo/internal/reader"
 	"github.com/gligneul/nonodo/internal/rollup"
+	"github.com/gligneul/nonodo/internal/sequencer"
+	"github.com/gligneul/nonodo/internal/servermanager"
 	"github.com/gligneul/nonodo/internal/supervisor"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -24,6 +29,8 @@ import (
 
 const DefaultHttpPort = 8080
 const HttpTimeout = 10 * time.Second
+const DefaultRestartMax = 10
+const DefaultMachineRAMSize = 128 << 20
 
 // Options to nonodo.
 type NonodoOpts struct {
@@ -40,34 +47,177 @@ type NonodoOpts struct {
 	// If RpcUrl is set, connect to it instead of anvil.
 	RpcUrl string
 
+	// If set, persist the inputs fetched from the RpcUrl, so nonodo resumes from the last
+	// fetched block after restarting.
+	RpcCache bool
+
+	// Directory of the RpcCache; if empty, use a directory in the user cache directory.
+	RpcCacheDir string
+
+	// Interval between checks for new blocks in the Ethereum node.
+	RpcPollInterval time.Duration
+
+	// Number of blocks nonodo waits after the block of an input before processing it.
+	RpcConfirmationDepth uint64
+
+	// Delay of the new inputs from Ethereum before they reach the application.
+	// In the meantime, the inputs are pending in the model.
+	InputLatency inputter.Latency
+
+	// If set, nonodo doesn't read inputs from Ethereum, so it doesn't start Anvil either.
+	// In this case, the inputs should be added directly to the model.
+	DisableInputter bool
+
 	// If set, start echo dapp.
 	EnableEcho bool
 
+	// If set, accept the EIP-712 signed messages of the local sequencer as advance inputs.
+	Sequencer bool
+
+	// Chain id in the EIP-712 domain of the sequencer messages.
+	SequencerChainID uint64
+
+	// If set, start the mock backend, which responds to the inputs following these rules.
+	MockBackend *mockapp.Rules
+
+	// If set, process the inputs in a Cartesi machine through the server-manager at this address.
+	ServerManagerAddress string
+
+	// Id of the server-manager session.
+	ServerManagerSession string
+
+	// If set, start the server-manager session with the machine snapshot in this directory.
+	// Otherwise, the session should already exist.
+	ServerManagerMachine string
+
 	// If set, start application.
 	ApplicationArgs []string
+
+	// If set, the application uses the mock mode of libcmt instead of the rollup API.
+	Libcmt bool
+
+	// Chain id sent to the libcmt application in the advance inputs.
+	LibcmtChainID uint64
+
+	// Probabilities of injecting faults in the rollup API.
+	// The faults can be changed at runtime with the admin endpoint.
+	RollupFaults rollup.Faults
+
+	// Number of rollup API exchanges kept in the journal; zero disables the journal.
+	RollupJournalSize int
+
+	// Restart policies for the application and the inputter.
+	RestartApp      supervisor.RestartPolicy
+	RestartInputter supervisor.RestartPolicy
+
+	// Maximum number of restarts for each worker; zero means no limit.
+	RestartMax int
+
+	// Delay before the first restart, which grows exponentially.
+	RestartBackoff time.Duration
+
+	// Mechanism used to limit the resources of the application.
+	LimitMode supervisor.LimitMode
+
+	// Resource limits of the application.
+	// The zero value of each limit means deriving it from the machine RAM size.
+	LimitMemory    int64
+	LimitCPU       float64
+	LimitOpenFiles uint64
+
+	// RAM size of the target Cartesi machine in bytes.
+	MachineRAMSize int64
+
+	// If set, run a replica of the application and compare their outputs for each advance.
+	CheckDeterminism bool
+
+	// Number of application replicas that serve the inspects.
+	InspectReplicas int
+
+	// If set, run the application in a sandbox without network access.
+	Sandbox bool
+
+	// If greater than one, throttle the application so it runs this many times slower,
+	// emulating the performance of the Cartesi machine.
+	Slowdown float64
+
+	// View of the file system inside the sandbox.
+	SandboxFS supervisor.SandboxFS
+
+	// If set, check the requests of the application against the rollup API spec and the
+	// behavior of the real server, reporting the conformance violations.
+	Strict bool
+
+	// If set, restart the application when the files matching these glob patterns change.
+	WatchPatterns []string
+
+	// If set, run this shell script to build the application before restarting it.
+	WatchBuild string
+
+	// If set, the application processes all advance inputs again after restarting.
+	WatchReplay bool
 }
 
 // Create the options struct with default values.
 func NewNonodoOpts() NonodoOpts {
 	return NonodoOpts{
-		AnvilPort:          devnet.AnvilDefaultPort,
-		AnvilVerbose:       false,
-		HttpAddress:        "127.0.0.1",
-		HttpPort:           DefaultHttpPort,
-		InputBoxAddress:    devnet.InputBoxAddress,
-		InputBoxBlock:      0,
-		ApplicationAddress: devnet.ApplicationAddress,
-		RpcUrl:             "",
-		EnableEcho:         false,
-		ApplicationArgs:    nil,
+		AnvilPort:            devnet.AnvilDefaultPort,
+		AnvilVerbose:         false,
+		HttpAddress:          "127.0.0.1",
+		HttpPort:             DefaultHttpPort,
+		InputBoxAddress:      devnet.InputBoxAddress,
+		InputBoxBlock:        0,
+		ApplicationAddress:   devnet.ApplicationAddress,
+		RpcUrl:               "",
+		RpcCache:             false,
+		RpcCacheDir:          "",
+		RpcPollInterval:      inputter.DefaultPollInterval,
+		RpcConfirmationDepth: 0,
+		InputLatency:         inputter.Latency{},
+		DisableInputter:      false,
+		EnableEcho:           false,
+		MockBackend:          nil,
+		Sequencer:            false,
+		SequencerChainID:     sequencer.DefaultChainID,
+		ServerManagerAddress: "",
+		ServerManagerSession: servermanager.DefaultSessionID,
+		ServerManagerMachine: "",
+		ApplicationArgs:      nil,
+		Libcmt:               false,
+		LibcmtChainID:        libcmt.DefaultChainID,
+		CheckDeterminism:     false,
+		InspectReplicas:      0,
+		LimitMode:            supervisor.LimitNone,
+		LimitMemory:          0,
+		LimitCPU:             0,
+		LimitOpenFiles:       0,
+		MachineRAMSize:       DefaultMachineRAMSize,
+		RollupFaults:         rollup.Faults{},
+		RollupJournalSize:    rollup.DefaultJournalSize,
+		RestartApp:           supervisor.RestartNever,
+		RestartInputter:      supervisor.RestartNever,
+		RestartMax:           DefaultRestartMax,
+		RestartBackoff:       supervisor.DefaultRestartBackoff,
+		Sandbox:              false,
+		SandboxFS:            supervisor.SandboxFSHost,
+		Slowdown:             0,
+		Strict:               false,
+		WatchPatterns:        nil,
+		WatchBuild:           "",
+		WatchReplay:          false,
 	}
 }
 
 // Create the nonodo supervisor.
 func NewSupervisor(opts NonodoOpts) supervisor.SupervisorWorker {
+	return NewSupervisorWithModel(opts, model.NewNonodoModel())
+}
+
+// Create the nonodo supervisor using the given model.
+// This is useful when the caller needs to access the model directly.
+func NewSupervisorWithModel(opts NonodoOpts, model *model.NonodoModel) supervisor.SupervisorWorker {
 	var w supervisor.SupervisorWorker
 
-	model := model.NewNonodoModel()
 	e := echo.New()
 	e.Use(middleware.CORS())
 	e.Use(middleware.Recover())
@@ -75,39 +225,227 @@ func NewSupervisor(opts NonodoOpts) supervisor.SupervisorWorker {
 		ErrorMessage: "Request timed out",
 		Timeout:      HttpTimeout,
 	}))
-	rollup.Register(e, model)
-	inspect.Register(e, model)
+	var journal *rollup.Journal
+	if opts.RollupJournalSize > 0 {
+		journal = rollup.NewJournal(model, opts.RollupJournalSize)
+	}
+	rollup.RegisterWithOpts(e, model, rollup.Opts{
+		Faults:  rollup.NewFaultInjector(opts.RollupFaults),
+		Strict:  opts.Strict,
+		Journal: journal,
+	})
+	var pool *inspectPool
+	if opts.InspectReplicas > 0 && len(opts.ApplicationArgs) > 0 {
+		pool = newInspectPool(model)
+		inspect.Register(e, pool)
+	} else {
+		inspect.Register(e, model)
+	}
 	reader.Register(e, model)
+	if opts.RpcUrl == "" && !opts.DisableInputter {
+		devnet.RegisterAdmin(e, fmt.Sprintf("http://127.0.0.1:%v", opts.AnvilPort))
+	}
+	if opts.Sequencer {
+		sequencer.NewSequencer(model, opts.SequencerChainID,
+			common.HexToAddress(opts.ApplicationAddress)).Register(e)
+	}
 
-	if opts.RpcUrl == "" {
-		w.Workers = append(w.Workers, devnet.AnvilWorker{
-			Port:    opts.AnvilPort,
-			Verbose: opts.AnvilVerbose,
-		})
-		opts.RpcUrl = fmt.Sprintf("ws://127.0.0.1:%v", opts.AnvilPort)
-	}
-	w.Workers = append(w.Workers, inputter.InputterWorker{
-		Model:              model,
-		Provider:           opts.RpcUrl,
-		InputBoxAddress:    common.HexToAddress(opts.InputBoxAddress),
-		InputBoxBlock:      opts.InputBoxBlock,
-		ApplicationAddress: common.HexToAddress(opts.ApplicationAddress),
-	})
+	// the application and its replicas are stopped while the inputter rolls back the inputs
+	// orphaned by a reorg, and the replicas are reset to receive the remaining inputs again
+	var replays []*replayWorker
+	var resets []func()
+	if !opts.DisableInputter {
+		// anvil starts from scratch every time, so only cache the inputs of external nodes
+		var cacheDir string
+		if opts.RpcCache && opts.RpcUrl != "" {
+			cacheDir = opts.RpcCacheDir
+			if cacheDir == "" {
+				cacheDir = inputter.DefaultCacheDir()
+			}
+		}
+		if opts.RpcUrl == "" {
+			w.Workers = append(w.Workers, devnet.AnvilWorker{
+				Port:    opts.AnvilPort,
+				Verbose: opts.AnvilVerbose,
+			})
+			opts.RpcUrl = fmt.Sprintf("ws://127.0.0.1:%v", opts.AnvilPort)
+		}
+		w.Workers = append(w.Workers, withRestart(opts, opts.RestartInputter, nil,
+			inputter.InputterWorker{
+				Model:              model,
+				Provider:           opts.RpcUrl,
+				InputBoxAddress:    common.HexToAddress(opts.InputBoxAddress),
+				InputBoxBlock:      opts.InputBoxBlock,
+				ApplicationAddress: common.HexToAddress(opts.ApplicationAddress),
+				CacheDir:           cacheDir,
+				PollInterval:       opts.RpcPollInterval,
+				ConfirmationDepth:  opts.RpcConfirmationDepth,
+				Latency:            opts.InputLatency,
+				Rollback: func(count int) {
+					rollbackInputs(model, count, replays, resets)
+				},
+			}))
+	}
+	if opts.Strict {
+		w.Workers = append(w.Workers, strictReporter{model})
+	}
 	w.Workers = append(w.Workers, supervisor.HttpWorker{
 		Address: fmt.Sprintf("%v:%v", opts.HttpAddress, opts.HttpPort),
 		Handler: e,
 	})
+	var appWorker supervisor.Worker
 	if len(opts.ApplicationArgs) > 0 {
-		w.Workers = append(w.Workers, supervisor.CommandWorker{
+		app := supervisor.CommandWorker{
 			Name:    "app",
 			Command: opts.ApplicationArgs[0],
 			Args:    opts.ApplicationArgs[1:],
-		})
+			OnExit:  haltOnExit(model),
+			OnLog:   model.AddLog,
+			Limits:  appLimits(opts),
+
+			OutputSync: &supervisor.OutputSync{},
+		}
+		model.SetLogSync(app.OutputSync.Sync)
+		if opts.Sandbox {
+			app.Sandbox = &supervisor.Sandbox{
+				Forward: []string{sandboxForwardAddress(opts)},
+				FS:      opts.SandboxFS,
+			}
+		}
+		meter := &usageMeter{}
+		model.SetUsageMeter(meter)
+		app.OnStart = meter.setProcess
+		if opts.Slowdown > 1 {
+			model.AddObserver(slowdownReporter{slowdown: opts.Slowdown})
+			if app.Limits != nil && app.Limits.Mode == supervisor.LimitCgroup {
+				// the cgroup CPU quota throttles the application more smoothly
+				app.Limits.CPU /= opts.Slowdown
+			} else {
+				app.Slowdown = opts.Slowdown
+			}
+		}
+		if opts.CheckDeterminism {
+			replica, checker := newDeterminismReplica(opts, e, model, app)
+			replays = append(replays, newReplayWorker(replica))
+			resets = append(resets, checker.reset)
+		}
+		for i := 0; pool != nil && i < opts.InspectReplicas; i++ {
+			replica, replicaModel := newReplica(opts, e, app, fmt.Sprintf("inspect-%v", i))
+			pool.addReplica(replicaModel)
+			replays = append(replays, newReplayWorker(replica))
+		}
+		if pool != nil {
+			resets = append(resets, pool.reset)
+		}
+		if opts.Libcmt {
+			appWorker = withRestart(opts, opts.RestartApp,
+				model.ResetCurrentInput, libcmt.LibcmtWorker{
+					Model:              model,
+					App:                app,
+					ApplicationAddress: common.HexToAddress(opts.ApplicationAddress),
+					ChainID:            opts.LibcmtChainID,
+				})
+		} else if len(opts.WatchPatterns) > 0 {
+			appWorker = supervisor.WatchWorker{
+				CommandWorker: app,
+				Patterns:      opts.WatchPatterns,
+				Build:         opts.WatchBuild,
+				OnRestart: func() {
+					if opts.WatchReplay {
+						model.ResetAdvanceInputs()
+					} else {
+						model.ResetCurrentInput()
+					}
+				},
+			}
+		} else {
+			appWorker = withRestart(opts, opts.RestartApp, model.ResetCurrentInput, app)
+		}
 	} else if opts.EnableEcho {
-		w.Workers = append(w.Workers, echoapp.EchoAppWorker{
-			RollupEndpoint: fmt.Sprintf("http://127.0.0.1:%v/rollup", opts.HttpPort),
-		})
+		appWorker = withRestart(opts, opts.RestartApp, model.ResetCurrentInput,
+			echoapp.EchoAppWorker{
+				RollupEndpoint: fmt.Sprintf("http://127.0.0.1:%v/rollup", opts.HttpPort),
+			})
+	} else if opts.MockBackend != nil {
+		appWorker = withRestart(opts, opts.RestartApp, model.ResetCurrentInput,
+			mockapp.MockAppWorker{
+				RollupEndpoint: fmt.Sprintf("http://127.0.0.1:%v/rollup", opts.HttpPort),
+				Rules:          opts.MockBackend,
+			})
+	} else if opts.ServerManagerAddress != "" {
+		backend := servermanager.NewGrpcBackend(opts.ServerManagerAddress,
+			opts.ServerManagerSession, opts.ServerManagerMachine)
+		// the session restarts from the machine snapshot to replay the inputs
+		resets = append(resets, backend.Reset)
+		appWorker = withRestart(opts, opts.RestartApp, model.ResetCurrentInput,
+			servermanager.ServerManagerWorker{
+				Model:   model,
+				Backend: backend,
+			})
+	}
+	if appWorker != nil {
+		replays = append(replays, newReplayWorker(appWorker))
+	}
+	for _, replay := range replays {
+		w.Workers = append(w.Workers, replay)
 	}
 
 	return w
 }
+
+// Mark the current input as halted when the application exits.
+func haltOnExit(m *model.NonodoModel) func(supervisor.CommandExit) {
+	return func(exit supervisor.CommandExit) {
+		m.HaltCurrentInput(model.MachineHalt{
+			ExitCode: exit.ExitCode,
+			Stderr:   exit.Stderr,
+			Reason:   exit.Reason,
+		})
+	}
+}
+
+// Get the resource limits of the application, if there are any.
+func appLimits(opts NonodoOpts) *supervisor.Limits {
+	if opts.LimitMode == "" || opts.LimitMode == supervisor.LimitNone {
+		return nil
+	}
+	limits := supervisor.MachineLimits(opts.LimitMode, opts.MachineRAMSize)
+	if opts.LimitMemory != 0 {
+		limits.Memory = opts.LimitMemory
+	}
+	if opts.LimitCPU != 0 {
+		limits.CPU = opts.LimitCPU
+	}
+	if opts.LimitOpenFiles != 0 {
+		limits.OpenFiles = opts.LimitOpenFiles
+	}
+	return &limits
+}
+
+// Get the host address of the rollup API that should be available inside the sandbox.
+func sandboxForwardAddress(opts NonodoOpts) string {
+	host := opts.HttpAddress
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "127.0.0.1"
+	}
+	return net.JoinHostPort(host, fmt.Sprint(opts.HttpPort))
+}
+
+// Wrap the worker with the restart policy, if needed.
+func withRestart(
+	opts NonodoOpts,
+	policy supervisor.RestartPolicy,
+	onRestart func(),
+	worker supervisor.Worker,
+) supervisor.Worker {
+	if policy == "" || policy == supervisor.RestartNever {
+		return worker
+	}
+	return supervisor.RestartWorker{
+		Worker:      worker,
+		Policy:      policy,
+		MaxRestarts: opts.RestartMax,
+		Backoff:     opts.RestartBackoff,
+		OnRestart:   onRestart,
+	}
+}
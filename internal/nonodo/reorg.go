@@ -0,0 +1,137 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package nonodo
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/supervisor"
+)
+
+// This worker runs the application and replays the advance inputs after a reorg.
+// When the inputter rolls back the orphaned inputs, this worker stops the application, runs the
+// rollback, and starts the application again, so it processes the remaining inputs from scratch.
+type replayWorker struct {
+	worker   supervisor.Worker
+	requests chan replayRequest
+	stopped  chan struct{}
+}
+
+// Request to stop the application while running the rollback.
+type replayRequest struct {
+	rollback func()
+	done     chan struct{}
+}
+
+func newReplayWorker(worker supervisor.Worker) *replayWorker {
+	return &replayWorker{
+		worker:   worker,
+		requests: make(chan replayRequest),
+		stopped:  make(chan struct{}),
+	}
+}
+
+func (w *replayWorker) String() string {
+	return w.worker.String()
+}
+
+func (w *replayWorker) Start(ctx context.Context, ready chan<- struct{}) error {
+	defer close(w.stopped)
+	var readyOnce sync.Once
+	for {
+		runCtx, cancel := context.WithCancel(ctx)
+		innerReady := make(chan struct{}, 1)
+		result := make(chan error, 1)
+		go func() {
+			result <- w.worker.Start(runCtx, innerReady)
+		}()
+		request, err := w.wait(ctx, ready, &readyOnce, innerReady, result)
+		cancel()
+		if request == nil {
+			return err
+		}
+		<-result
+		slog.Info("nonodo: replaying the advance inputs after the rollback", "worker", w)
+		request.rollback()
+		close(request.done)
+	}
+}
+
+// Wait until the application exits or there is a rollback request.
+// Forward the first ready signal of the application.
+func (w *replayWorker) wait(
+	ctx context.Context,
+	ready chan<- struct{},
+	readyOnce *sync.Once,
+	innerReady <-chan struct{},
+	result <-chan error,
+) (*replayRequest, error) {
+	for {
+		select {
+		case <-innerReady:
+			readyOnce.Do(func() {
+				select {
+				case ready <- struct{}{}:
+				case <-ctx.Done():
+				}
+			})
+		case err := <-result:
+			return nil, err
+		case request := <-w.requests:
+			return &request, nil
+		}
+	}
+}
+
+// Stop the application, run the rollback, and start the application again.
+// If the worker already stopped, only run the rollback.
+func (w *replayWorker) rollback(rollback func()) {
+	request := replayRequest{
+		rollback: rollback,
+		done:     make(chan struct{}),
+	}
+	select {
+	case w.requests <- request:
+		<-request.done
+	case <-w.stopped:
+		rollback()
+	}
+}
+
+// Roll back the InputBox inputs after the first count ones, which were orphaned by a reorg.
+// If advance inputs were orphaned, stop the application and its replicas, roll back the model,
+// reset the replicas, and start them again, so they process the remaining inputs from scratch.
+func rollbackInputs(
+	m *model.NonodoModel,
+	count int,
+	replays []*replayWorker,
+	resets []func(),
+) {
+	advances := m.GetNumInputBoxInputs() - len(m.GetPendingInputs())
+	if count >= advances {
+		// only pending inputs were orphaned, so the application doesn't need to replay
+		m.RollbackInputBoxInputs(count)
+		return
+	}
+	stopAll(replays, func() {
+		m.RollbackInputBoxInputs(count)
+		for _, reset := range resets {
+			reset()
+		}
+	})
+}
+
+// Stop the workers, run the rollback, and start the workers again.
+func stopAll(replays []*replayWorker, rollback func()) {
+	if len(replays) == 0 {
+		rollback()
+		return
+	}
+	replays[0].rollback(func() {
+		stopAll(replays[1:], rollback)
+	})
+}
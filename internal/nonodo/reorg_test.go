@@ -0,0 +1,142 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package nonodo
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Worker that runs until the context is canceled, counting its starts.
+type countingWorker struct {
+	starts *atomic.Int32
+}
+
+func (w countingWorker) String() string {
+	return "counting"
+}
+
+func (w countingWorker) Start(ctx context.Context, ready chan<- struct{}) error {
+	w.starts.Add(1)
+	ready <- struct{}{}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestReplayWorkerRestartsAfterRollback(t *testing.T) {
+	var starts atomic.Int32
+	w := newReplayWorker(countingWorker{&starts})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	ready := make(chan struct{})
+	result := make(chan error)
+	go func() {
+		result <- w.Start(ctx, ready)
+	}()
+	<-ready
+
+	// the rollback runs while the inner worker is stopped
+	var startsDuringRollback int32
+	w.rollback(func() { startsDuringRollback = starts.Load() })
+	assert.Equal(t, int32(1), startsDuringRollback)
+	assert.Eventually(t, func() bool {
+		return starts.Load() == 2
+	}, time.Second, time.Millisecond)
+
+	// after the worker stops, the rollback runs directly
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+	rolledBack := false
+	w.rollback(func() { rolledBack = true })
+	assert.True(t, rolledBack)
+	assert.Equal(t, int32(2), starts.Load())
+}
+
+func TestRollbackInputsResetsReplicas(t *testing.T) {
+	app := model.NewNonodoModel()
+	pool := newInspectPool(app)
+	inspectModel := model.NewNonodoModel()
+	pool.addReplica(inspectModel)
+	determinismModel := model.NewNonodoModel()
+	checker := newDeterminismChecker(determinismModel)
+	app.AddObserver(checker.appObserver())
+	determinismModel.AddObserver(checker.replicaObserver())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var starts atomic.Int32
+	var replays []*replayWorker
+	for i := 0; i < 3; i++ {
+		replay := newReplayWorker(countingWorker{&starts})
+		ready := make(chan struct{})
+		go func() {
+			_ = replay.Start(ctx, ready)
+		}()
+		<-ready
+		replays = append(replays, replay)
+	}
+	resets := []func(){checker.reset, pool.reset}
+
+	// the application and the replicas process three inputs
+	for i := 0; i < 3; i++ {
+		app.AddAdvanceInput(common.Address{}, []byte(fmt.Sprint(i)), uint64(i), time.Now())
+	}
+	processAll(t, app, inspectModel, determinismModel)
+	require.Equal(t, 3, inspectModel.GetNumInputs(model.InputFilter{}))
+	require.Equal(t, 3, determinismModel.GetNumInputs(model.InputFilter{}))
+
+	// orphaned pending inputs don't restart the workers
+	app.AddPendingInput(model.PendingInput{Payload: []byte("pending")})
+	rollbackInputs(app, 3, replays, resets)
+	assert.Empty(t, app.GetPendingInputs())
+	assert.Equal(t, 3, inspectModel.GetNumInputs(model.InputFilter{}))
+	assert.Equal(t, int32(3), starts.Load())
+
+	// a reorg orphans the last two inputs, so the replicas lose all of them
+	rollbackInputs(app, 1, replays, resets)
+	assert.Eventually(t, func() bool {
+		return starts.Load() == 6
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 1, app.GetNumInputs(model.InputFilter{}))
+	assert.Equal(t, 0, inspectModel.GetNumInputs(model.InputFilter{}))
+	assert.Equal(t, 0, determinismModel.GetNumInputs(model.InputFilter{}))
+
+	// the replicas receive the remaining input again when the application replays it
+	processAll(t, app, inspectModel, determinismModel)
+	for _, replica := range []*model.NonodoModel{inspectModel, determinismModel} {
+		inputs := replica.GetInputs(model.InputFilter{}, 0, 100)
+		require.Len(t, inputs, 1)
+		assert.Equal(t, "0", string(inputs[0].Payload))
+		assert.Equal(t, model.CompletionStatusAccepted, inputs[0].Status)
+	}
+	checker.mutex.Lock()
+	assert.Empty(t, checker.pending[determinismApp])
+	assert.Empty(t, checker.pending[determinismReplica])
+	checker.mutex.Unlock()
+
+	// the inspect replica caught up, so it serves the next inspect
+	index := pool.AddInspectInput([]byte("inspect"))
+	_, ok := inspectModel.FinishAndGetNext(true).(model.InspectInput)
+	assert.True(t, ok)
+	inspectModel.FinishAndGetNext(true)
+	assert.Equal(t, 1, pool.GetInspectInput(index).ProccessedInputCount)
+}
+
+// Accept the inputs in the application and then in the replicas.
+func processAll(t *testing.T, app *model.NonodoModel, replicas ...*model.NonodoModel) {
+	for _, m := range append([]*model.NonodoModel{app}, replicas...) {
+		for input := m.FinishAndGetNext(true); input != nil; input = m.FinishAndGetNext(true) {
+			_, ok := input.(model.AdvanceInput)
+			require.True(t, ok)
+		}
+	}
+}
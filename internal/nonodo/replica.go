@@ -0,0 +1,43 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package nonodo
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/rollup"
+	"github.com/gligneul/nonodo/internal/supervisor"
+	"github.com/labstack/echo/v4"
+)
+
+// Environment variable with the rollup API URL used by Cartesi applications.
+const rollupURLEnv = "ROLLUP_HTTP_SERVER_URL"
+
+// Create a replica of the application with its own model and rollup API.
+// The replica runs the same command as the application, but it should get the rollup API URL
+// from the ROLLUP_HTTP_SERVER_URL environment variable.
+func newReplica(
+	opts NonodoOpts,
+	e *echo.Echo,
+	app supervisor.CommandWorker,
+	name string,
+) (supervisor.Worker, *model.NonodoModel) {
+	replicaModel := model.NewNonodoModel()
+	path := fmt.Sprintf("replicas/%v/rollup", name)
+	rollup.RegisterWithBaseURL(e, replicaModel, path)
+
+	replica := app
+	replica.Name = fmt.Sprintf("replica-%v", name)
+	replica.Env = append(os.Environ(), fmt.Sprintf("%v=http://%v/%v",
+		rollupURLEnv, sandboxForwardAddress(opts), path))
+	replica.OnStart = nil
+	replica.OnExit = haltOnExit(replicaModel)
+	replica.OnLog = replicaModel.AddLog
+	replica.OutputSync = &supervisor.OutputSync{}
+	replicaModel.SetLogSync(replica.OutputSync.Sync)
+	worker := withRestart(opts, opts.RestartApp, replicaModel.ResetCurrentInput, replica)
+	return worker, replicaModel
+}
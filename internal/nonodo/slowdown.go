@@ -0,0 +1,42 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package nonodo
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gligneul/nonodo/internal/model"
+)
+
+// Reports the wall-clock time the throttled application takes to process each input, next to
+// the projected time inside the Cartesi machine, which is the CPU time multiplied by the slowdown.
+type slowdownReporter struct {
+	slowdown float64
+}
+
+func (r slowdownReporter) InputStarted(input model.Input) {
+	// Do nothing
+}
+
+func (r slowdownReporter) InputFinished(input model.Input) {
+	var kind string
+	var index int
+	var usage *model.InputUsage
+	switch input := input.(type) {
+	case model.AdvanceInput:
+		kind, index, usage = "advance", input.Index, input.Usage
+	case model.InspectInput:
+		kind, index, usage = "inspect", input.Index, input.Usage
+	}
+	if usage == nil {
+		return
+	}
+	args := []any{"kind", kind, "index", index, "wall", usage.FinishedAt.Sub(usage.StartedAt)}
+	if usage.Resources != nil {
+		projected := time.Duration(float64(usage.Resources.CPUTime) * r.slowdown)
+		args = append(args, "projected", projected)
+	}
+	slog.Info("nonodo: input timing", args...)
+}
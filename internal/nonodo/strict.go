@@ -0,0 +1,64 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package nonodo
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+
+	"github.com/gligneul/nonodo/internal/model"
+)
+
+// Worker that logs the summary of the conformance violations when nonodo shuts down.
+type strictReporter struct {
+	model *model.NonodoModel
+}
+
+func (w strictReporter) String() string {
+	return "strict"
+}
+
+func (w strictReporter) Start(ctx context.Context, ready chan<- struct{}) error {
+	ready <- struct{}{}
+	<-ctx.Done()
+	w.report()
+	return ctx.Err()
+}
+
+// Log the violations of each input.
+func (w strictReporter) report() {
+	violations := w.model.GetViolations()
+	if len(violations.Advances) == 0 && len(violations.Inspects) == 0 &&
+		len(violations.Idle) == 0 {
+		slog.Info("nonodo: strict mode found no conformance violations")
+		return
+	}
+	slog.Warn("nonodo: strict mode found conformance violations",
+		"advances", len(violations.Advances), "inspects", len(violations.Inspects),
+		"idle", len(violations.Idle))
+	for _, index := range sortedKeys(violations.Advances) {
+		for _, violation := range violations.Advances[index] {
+			slog.Warn("nonodo: advance violation", "index", index, "violation", violation)
+		}
+	}
+	for _, index := range sortedKeys(violations.Inspects) {
+		for _, violation := range violations.Inspects[index] {
+			slog.Warn("nonodo: inspect violation", "index", index, "violation", violation)
+		}
+	}
+	for _, violation := range violations.Idle {
+		slog.Warn("nonodo: violation without input", "violation", violation)
+	}
+}
+
+// Get the keys of the map in increasing order.
+func sortedKeys(m map[int][]string) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	return keys
+}
@@ -0,0 +1,122 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package nonodo
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/supervisor"
+)
+
+// Interval between the samples of the application memory.
+const usageSampleInterval = 50 * time.Millisecond
+
+// Measures the resources used by the application process group for each input.
+// The CPU time and I/O come from the difference between the samples the model passes to Start
+// and Stop, and the peak memory from the largest sample.
+type usageMeter struct {
+	// Process group of the application; zero if it didn't start.
+	pgid atomic.Int64
+
+	// Sampler of the current input; nil if the meter is stopped.
+	sampler *usageSampler
+}
+
+// Samples the application memory while it processes an input.
+type usageSampler struct {
+	mutex      sync.Mutex
+	start      model.UsageSample
+	peakMemory int64
+	done       chan struct{}
+}
+
+// Set the process group of the application after it starts.
+func (m *usageMeter) setProcess(pid int) {
+	m.pgid.Store(int64(pid))
+}
+
+func (m *usageMeter) Sample() (model.UsageSample, bool) {
+	usage, ok := m.sample()
+	if !ok {
+		return model.UsageSample{}, false
+	}
+	return model.UsageSample{
+		CPUTime:    usage.CPUTime,
+		Memory:     usage.Memory,
+		ReadBytes:  usage.ReadBytes,
+		WriteBytes: usage.WriteBytes,
+	}, true
+}
+
+func (m *usageMeter) Start(sample *model.UsageSample) {
+	m.Stop(nil)
+	if sample == nil {
+		return
+	}
+	s := &usageSampler{
+		start:      *sample,
+		peakMemory: sample.Memory,
+		done:       make(chan struct{}),
+	}
+	m.sampler = s
+	go func() {
+		ticker := time.NewTicker(usageSampleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.done:
+				return
+			case <-ticker.C:
+				if usage, ok := m.sample(); ok {
+					s.updatePeak(usage.Memory)
+				}
+			}
+		}
+	}()
+}
+
+func (m *usageMeter) Stop(sample *model.UsageSample) (model.ResourceUsage, bool) {
+	s := m.sampler
+	if s == nil {
+		return model.ResourceUsage{}, false
+	}
+	m.sampler = nil
+	// the model holds its lock while stopping the meter, so don't wait for the sampler to exit
+	close(s.done)
+	if sample == nil {
+		// the application exited, so only the peak memory is known
+		return model.ResourceUsage{PeakMemory: s.updatePeak(0)}, true
+	}
+	return model.ResourceUsage{
+		CPUTime:    sample.CPUTime - s.start.CPUTime,
+		PeakMemory: s.updatePeak(sample.Memory),
+		ReadBytes:  sample.ReadBytes - s.start.ReadBytes,
+		WriteBytes: sample.WriteBytes - s.start.WriteBytes,
+	}, true
+}
+
+// Sample the resources of the application; return false if they are not available.
+func (m *usageMeter) sample() (supervisor.ProcessUsage, bool) {
+	pgid := int(m.pgid.Load())
+	if pgid == 0 {
+		return supervisor.ProcessUsage{}, false
+	}
+	usage, err := supervisor.ProcessGroupUsage(pgid)
+	if err != nil {
+		return supervisor.ProcessUsage{}, false
+	}
+	return usage, true
+}
+
+// Update the peak memory with the sample and return it.
+func (s *usageSampler) updatePeak(memory int64) int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.peakMemory = max(s.peakMemory, memory)
+	return s.peakMemory
+}
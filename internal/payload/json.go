@@ -0,0 +1,88 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package payload
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse the JSON path into a list of steps.
+// Each step is a string, for object fields, or an int, for array indices.
+func ParseJSONPath(path string) ([]any, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("invalid JSON path %q: must start with $", path)
+	}
+	var steps []any
+	rest := path[1:]
+	for len(rest) > 0 {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end == -1 {
+				end = len(rest) - 1
+			}
+			field := rest[1 : end+1]
+			if field == "" {
+				return nil, fmt.Errorf("invalid JSON path %q: empty field", path)
+			}
+			steps = append(steps, field)
+			rest = rest[end+1:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("invalid JSON path %q: missing ]", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON path %q: %w", path, err)
+			}
+			steps = append(steps, index)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid JSON path %q", path)
+		}
+	}
+	return steps, nil
+}
+
+// Look up the value in the decoded JSON document.
+func LookupJSONPath(document any, steps []any) (any, bool) {
+	value := document
+	for _, step := range steps {
+		switch step := step.(type) {
+		case string:
+			object, ok := value.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			value, ok = object[step]
+			if !ok {
+				return nil, false
+			}
+		case int:
+			array, ok := value.([]any)
+			if !ok || step < 0 || step >= len(array) {
+				return nil, false
+			}
+			value = array[step]
+		}
+	}
+	return value, true
+}
+
+// Convert the value to the same representation used by the JSON decoder.
+func NormalizeJSON(value any) (any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON value: %w", err)
+	}
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, fmt.Errorf("invalid JSON value: %w", err)
+	}
+	return normalized, nil
+}
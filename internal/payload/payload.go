@@ -0,0 +1,86 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+// This package contains the payload type used in nonodo configuration files.
+package payload
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"gopkg.in/yaml.v3"
+)
+
+// Payload that can be written in YAML files using different encodings.
+//
+// A plain string is decoded as hex if it starts with 0x; otherwise, it is decoded as text.
+// A mapping must contain a single key that sets the encoding: text, hex, or json.
+// For json, the value is any YAML value, which is encoded as JSON.
+type Payload []byte
+
+func (p *Payload) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		var value string
+		if err := node.Decode(&value); err != nil {
+			return err
+		}
+		if strings.HasPrefix(value, "0x") {
+			return p.decodeHex(value)
+		}
+		*p = []byte(value)
+		return nil
+	case yaml.MappingNode:
+		// a mapping with a single entry has two nodes: the key and the value
+		const singleEntryLength = 2
+		if len(node.Content) != singleEntryLength {
+			return fmt.Errorf("line %v: payload must have a single encoding", node.Line)
+		}
+		var encoding string
+		if err := node.Content[0].Decode(&encoding); err != nil {
+			return err
+		}
+		value := node.Content[1]
+		switch encoding {
+		case "text":
+			var text string
+			if err := value.Decode(&text); err != nil {
+				return err
+			}
+			*p = []byte(text)
+			return nil
+		case "hex":
+			var hex string
+			if err := value.Decode(&hex); err != nil {
+				return err
+			}
+			return p.decodeHex(hex)
+		case "json":
+			var object any
+			if err := value.Decode(&object); err != nil {
+				return err
+			}
+			data, err := json.Marshal(object)
+			if err != nil {
+				return fmt.Errorf("line %v: %w", node.Line, err)
+			}
+			*p = data
+			return nil
+		default:
+			return fmt.Errorf("line %v: invalid payload encoding: %v", node.Line, encoding)
+		}
+	default:
+		return fmt.Errorf("line %v: invalid payload", node.Line)
+	}
+}
+
+func (p *Payload) decodeHex(value string) error {
+	data, err := hexutil.Decode(value)
+	if err != nil {
+		return fmt.Errorf("invalid hex payload: %w", err)
+	}
+	*p = data
+	return nil
+}
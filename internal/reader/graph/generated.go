@@ -51,7 +51,10 @@ type DirectiveRoot struct {
 type ComplexityRoot struct {
 	Input struct {
 		BlockNumber func(childComplexity int) int
+		Exception   func(childComplexity int) int
 		Index       func(childComplexity int) int
+		Logs        func(childComplexity int) int
+		MachineHalt func(childComplexity int) int
 		MsgSender   func(childComplexity int) int
 		Notice      func(childComplexity int, index int) int
 		Notices     func(childComplexity int, first *int, last *int, after *string, before *string) int
@@ -60,6 +63,8 @@ type ComplexityRoot struct {
 		Reports     func(childComplexity int, first *int, last *int, after *string, before *string) int
 		Status      func(childComplexity int) int
 		Timestamp   func(childComplexity int) int
+		Usage       func(childComplexity int) int
+		Violations  func(childComplexity int) int
 		Voucher     func(childComplexity int, index int) int
 		Vouchers    func(childComplexity int, first *int, last *int, after *string, before *string) int
 	}
@@ -75,6 +80,24 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	InputUsage struct {
+		FinishedAt func(childComplexity int) int
+		Resources  func(childComplexity int) int
+		StartedAt  func(childComplexity int) int
+	}
+
+	Log struct {
+		Line      func(childComplexity int) int
+		Stream    func(childComplexity int) int
+		Timestamp func(childComplexity int) int
+	}
+
+	MachineHalt struct {
+		ExitCode func(childComplexity int) int
+		Reason   func(childComplexity int) int
+		Stderr   func(childComplexity int) int
+	}
+
 	Notice struct {
 		Index   func(childComplexity int) int
 		Input   func(childComplexity int) int
@@ -111,20 +134,30 @@ type ComplexityRoot struct {
 		StartCursor     func(childComplexity int) int
 	}
 
+	PendingInput struct {
+		BlockNumber  func(childComplexity int) int
+		MsgSender    func(childComplexity int) int
+		Payload      func(childComplexity int) int
+		ReleaseAt    func(childComplexity int) int
+		ReleaseBlock func(childComplexity int) int
+		Timestamp    func(childComplexity int) int
+	}
+
 	Proof struct {
 		Context  func(childComplexity int) int
 		Validity func(childComplexity int) int
 	}
 
 	Query struct {
-		Input    func(childComplexity int, index int) int
-		Inputs   func(childComplexity int, first *int, last *int, after *string, before *string, where *model.InputFilter) int
-		Notice   func(childComplexity int, noticeIndex int, inputIndex int) int
-		Notices  func(childComplexity int, first *int, last *int, after *string, before *string) int
-		Report   func(childComplexity int, reportIndex int, inputIndex int) int
-		Reports  func(childComplexity int, first *int, last *int, after *string, before *string) int
-		Voucher  func(childComplexity int, voucherIndex int, inputIndex int) int
-		Vouchers func(childComplexity int, first *int, last *int, after *string, before *string) int
+		Input         func(childComplexity int, index int) int
+		Inputs        func(childComplexity int, first *int, last *int, after *string, before *string, where *model.InputFilter) int
+		Notice        func(childComplexity int, noticeIndex int, inputIndex int) int
+		Notices       func(childComplexity int, first *int, last *int, after *string, before *string) int
+		PendingInputs func(childComplexity int) int
+		Report        func(childComplexity int, reportIndex int, inputIndex int) int
+		Reports       func(childComplexity int, first *int, last *int, after *string, before *string) int
+		Voucher       func(childComplexity int, voucherIndex int, inputIndex int) int
+		Vouchers      func(childComplexity int, first *int, last *int, after *string, before *string) int
 	}
 
 	Report struct {
@@ -144,6 +177,13 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	ResourceUsage struct {
+		CPUTime    func(childComplexity int) int
+		PeakMemory func(childComplexity int) int
+		ReadBytes  func(childComplexity int) int
+		WriteBytes func(childComplexity int) int
+	}
+
 	Voucher struct {
 		Destination func(childComplexity int) int
 		Index       func(childComplexity int) int
@@ -184,6 +224,7 @@ type QueryResolver interface {
 	Vouchers(ctx context.Context, first *int, last *int, after *string, before *string) (*model.Connection[*model.Voucher], error)
 	Notices(ctx context.Context, first *int, last *int, after *string, before *string) (*model.Connection[*model.Notice], error)
 	Reports(ctx context.Context, first *int, last *int, after *string, before *string) (*model.Connection[*model.Report], error)
+	PendingInputs(ctx context.Context) ([]*model.PendingInput, error)
 }
 type ReportResolver interface {
 	Input(ctx context.Context, obj *model.Report) (*model.Input, error)
@@ -218,6 +259,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Input.BlockNumber(childComplexity), true
 
+	case "Input.exception":
+		if e.complexity.Input.Exception == nil {
+			break
+		}
+
+		return e.complexity.Input.Exception(childComplexity), true
+
 	case "Input.index":
 		if e.complexity.Input.Index == nil {
 			break
@@ -225,6 +273,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Input.Index(childComplexity), true
 
+	case "Input.logs":
+		if e.complexity.Input.Logs == nil {
+			break
+		}
+
+		return e.complexity.Input.Logs(childComplexity), true
+
+	case "Input.machineHalt":
+		if e.complexity.Input.MachineHalt == nil {
+			break
+		}
+
+		return e.complexity.Input.MachineHalt(childComplexity), true
+
 	case "Input.msgSender":
 		if e.complexity.Input.MsgSender == nil {
 			break
@@ -301,6 +363,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Input.Timestamp(childComplexity), true
 
+	case "Input.usage":
+		if e.complexity.Input.Usage == nil {
+			break
+		}
+
+		return e.complexity.Input.Usage(childComplexity), true
+
+	case "Input.violations":
+		if e.complexity.Input.Violations == nil {
+			break
+		}
+
+		return e.complexity.Input.Violations(childComplexity), true
+
 	case "Input.voucher":
 		if e.complexity.Input.Voucher == nil {
 			break
@@ -360,6 +436,69 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.InputEdge.Node(childComplexity), true
 
+	case "InputUsage.finishedAt":
+		if e.complexity.InputUsage.FinishedAt == nil {
+			break
+		}
+
+		return e.complexity.InputUsage.FinishedAt(childComplexity), true
+
+	case "InputUsage.resources":
+		if e.complexity.InputUsage.Resources == nil {
+			break
+		}
+
+		return e.complexity.InputUsage.Resources(childComplexity), true
+
+	case "InputUsage.startedAt":
+		if e.complexity.InputUsage.StartedAt == nil {
+			break
+		}
+
+		return e.complexity.InputUsage.StartedAt(childComplexity), true
+
+	case "Log.line":
+		if e.complexity.Log.Line == nil {
+			break
+		}
+
+		return e.complexity.Log.Line(childComplexity), true
+
+	case "Log.stream":
+		if e.complexity.Log.Stream == nil {
+			break
+		}
+
+		return e.complexity.Log.Stream(childComplexity), true
+
+	case "Log.timestamp":
+		if e.complexity.Log.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.Log.Timestamp(childComplexity), true
+
+	case "MachineHalt.exitCode":
+		if e.complexity.MachineHalt.ExitCode == nil {
+			break
+		}
+
+		return e.complexity.MachineHalt.ExitCode(childComplexity), true
+
+	case "MachineHalt.reason":
+		if e.complexity.MachineHalt.Reason == nil {
+			break
+		}
+
+		return e.complexity.MachineHalt.Reason(childComplexity), true
+
+	case "MachineHalt.stderr":
+		if e.complexity.MachineHalt.Stderr == nil {
+			break
+		}
+
+		return e.complexity.MachineHalt.Stderr(childComplexity), true
+
 	case "Notice.index":
 		if e.complexity.Notice.Index == nil {
 			break
@@ -507,6 +646,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.PageInfo.StartCursor(childComplexity), true
 
+	case "PendingInput.blockNumber":
+		if e.complexity.PendingInput.BlockNumber == nil {
+			break
+		}
+
+		return e.complexity.PendingInput.BlockNumber(childComplexity), true
+
+	case "PendingInput.msgSender":
+		if e.complexity.PendingInput.MsgSender == nil {
+			break
+		}
+
+		return e.complexity.PendingInput.MsgSender(childComplexity), true
+
+	case "PendingInput.payload":
+		if e.complexity.PendingInput.Payload == nil {
+			break
+		}
+
+		return e.complexity.PendingInput.Payload(childComplexity), true
+
+	case "PendingInput.releaseAt":
+		if e.complexity.PendingInput.ReleaseAt == nil {
+			break
+		}
+
+		return e.complexity.PendingInput.ReleaseAt(childComplexity), true
+
+	case "PendingInput.releaseBlock":
+		if e.complexity.PendingInput.ReleaseBlock == nil {
+			break
+		}
+
+		return e.complexity.PendingInput.ReleaseBlock(childComplexity), true
+
+	case "PendingInput.timestamp":
+		if e.complexity.PendingInput.Timestamp == nil {
+			break
+		}
+
+		return e.complexity.PendingInput.Timestamp(childComplexity), true
+
 	case "Proof.context":
 		if e.complexity.Proof.Context == nil {
 			break
@@ -569,6 +750,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Notices(childComplexity, args["first"].(*int), args["last"].(*int), args["after"].(*string), args["before"].(*string)), true
 
+	case "Query.pendingInputs":
+		if e.complexity.Query.PendingInputs == nil {
+			break
+		}
+
+		return e.complexity.Query.PendingInputs(childComplexity), true
+
 	case "Query.report":
 		if e.complexity.Query.Report == nil {
 			break
@@ -673,6 +861,34 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ReportEdge.Node(childComplexity), true
 
+	case "ResourceUsage.cpuTime":
+		if e.complexity.ResourceUsage.CPUTime == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsage.CPUTime(childComplexity), true
+
+	case "ResourceUsage.peakMemory":
+		if e.complexity.ResourceUsage.PeakMemory == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsage.PeakMemory(childComplexity), true
+
+	case "ResourceUsage.readBytes":
+		if e.complexity.ResourceUsage.ReadBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsage.ReadBytes(childComplexity), true
+
+	case "ResourceUsage.writeBytes":
+		if e.complexity.ResourceUsage.WriteBytes == nil {
+			break
+		}
+
+		return e.complexity.ResourceUsage.WriteBytes(childComplexity), true
+
 	case "Voucher.destination":
 		if e.complexity.Voucher.Destination == nil {
 			break
@@ -879,6 +1095,74 @@ type Input {
   notices(first: Int, last: Int, after: String, before: String): NoticeConnection!
   "Get reports from this particular input with support for pagination"
   reports(first: Int, last: Int, after: String, before: String): ReportConnection!
+  "Exception payload in Ethereum hex binary format if the application raised an exception"
+  exception: String
+  "Information about the application exit if the machine halted while processing the input"
+  machineHalt: MachineHalt
+  "Information about how the application processed the input; null if it wasn't processed"
+  usage: InputUsage
+  "Lines the application wrote to stdout and stderr while processing the input"
+  logs: [Log!]!
+  "Conformance violations of the rollup API found while processing the input in strict mode"
+  violations: [String!]!
+}
+
+"Information about the application exit that halted the machine"
+type MachineHalt {
+  "Exit code of the application; -1 if it was terminated by a signal"
+  exitCode: Int!
+  "Last lines the application wrote to stderr"
+  stderr: String!
+  "Reason nonodo stopped the application if it exceeded a resource limit"
+  reason: String
+}
+
+"Line the application wrote while processing an input"
+type Log {
+  "Stream the application wrote the line to: stdout or stderr"
+  stream: String!
+  "Content of the line"
+  line: String!
+  "Time when nonodo captured the line, in milliseconds since the Unix epoch"
+  timestamp: BigInt!
+}
+
+"Information about how the application processed an input"
+type InputUsage {
+  "Time when the application received the input, in milliseconds since the Unix epoch"
+  startedAt: BigInt!
+  "Time when the application finished processing the input, in milliseconds since the Unix epoch"
+  finishedAt: BigInt!
+  "Resources used by the application; null if nonodo didn't measure them"
+  resources: ResourceUsage
+}
+
+"Resources used by the application to process an input"
+type ResourceUsage {
+  "CPU time used by the application processes, in milliseconds"
+  cpuTime: BigInt!
+  "Peak resident memory of the application processes, in bytes"
+  peakMemory: BigInt!
+  "Bytes the application processes read from the storage"
+  readBytes: BigInt!
+  "Bytes the application processes wrote to the storage"
+  writeBytes: BigInt!
+}
+
+"Input from the base layer that nonodo holds before adding it to the inputs, emulating the latency of a test net"
+type PendingInput {
+  "Address responsible for submitting the input"
+  msgSender: String!
+  "Timestamp associated with the input submission, as defined by the base layer's block in which it was recorded"
+  timestamp: BigInt!
+  "Number of the base layer block in which the input was recorded"
+  blockNumber: BigInt!
+  "Input payload in Ethereum hex binary format, starting with '0x'"
+  payload: String!
+  "Time when nonodo releases the input, in milliseconds since the Unix epoch"
+  releaseAt: BigInt!
+  "Number of the base layer block that releases the input"
+  releaseBlock: BigInt!
 }
 
 "Validity proof for an output"
@@ -933,6 +1217,8 @@ type Query {
   notices(first: Int, last: Int, after: String, before: String): NoticeConnection!
   "Get reports with support for pagination"
   reports(first: Int, last: Int, after: String, before: String): ReportConnection!
+  "Get the inputs that nonodo holds before adding them to the inputs, in the order they will be added"
+  pendingInputs: [PendingInput!]!
 }
 
 "Pagination entry"
@@ -2198,8 +2484,8 @@ func (ec *executionContext) fieldContext_Input_reports(ctx context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _InputConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Input]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_InputConnection_totalCount(ctx, field)
+func (ec *executionContext) _Input_exception(ctx context.Context, field graphql.CollectedField, obj *model.Input) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Input_exception(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2212,38 +2498,35 @@ func (ec *executionContext) _InputConnection_totalCount(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Exception, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_InputConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Input_exception(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "InputConnection",
+		Object:     "Input",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _InputConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Input]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_InputConnection_edges(ctx, field)
+func (ec *executionContext) _Input_machineHalt(ctx context.Context, field graphql.CollectedField, obj *model.Input) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Input_machineHalt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2256,44 +2539,43 @@ func (ec *executionContext) _InputConnection_edges(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Edges, nil
+		return obj.MachineHalt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Edge[*model.Input])
+	res := resTmp.(*model.MachineHalt)
 	fc.Result = res
-	return ec.marshalNInputEdge2ᚕᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐEdgeᚄ(ctx, field.Selections, res)
+	return ec.marshalOMachineHalt2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐMachineHalt(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_InputConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Input_machineHalt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "InputConnection",
+		Object:     "Input",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "node":
-				return ec.fieldContext_InputEdge_node(ctx, field)
-			case "cursor":
-				return ec.fieldContext_InputEdge_cursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type InputEdge", field.Name)
+			case "exitCode":
+				return ec.fieldContext_MachineHalt_exitCode(ctx, field)
+			case "stderr":
+				return ec.fieldContext_MachineHalt_stderr(ctx, field)
+			case "reason":
+				return ec.fieldContext_MachineHalt_reason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type MachineHalt", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _InputConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Input]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_InputConnection_pageInfo(ctx, field)
+func (ec *executionContext) _Input_usage(ctx context.Context, field graphql.CollectedField, obj *model.Input) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Input_usage(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2306,48 +2588,43 @@ func (ec *executionContext) _InputConnection_pageInfo(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.Usage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PageInfo)
+	res := resTmp.(*model.InputUsage)
 	fc.Result = res
-	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalOInputUsage2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐInputUsage(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_InputConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Input_usage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "InputConnection",
+		Object:     "Input",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "startCursor":
-				return ec.fieldContext_PageInfo_startCursor(ctx, field)
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			case "startedAt":
+				return ec.fieldContext_InputUsage_startedAt(ctx, field)
+			case "finishedAt":
+				return ec.fieldContext_InputUsage_finishedAt(ctx, field)
+			case "resources":
+				return ec.fieldContext_InputUsage_resources(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type InputUsage", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _InputEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.Edge[*model.Input]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_InputEdge_node(ctx, field)
+func (ec *executionContext) _Input_logs(ctx context.Context, field graphql.CollectedField, obj *model.Input) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Input_logs(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2360,7 +2637,7 @@ func (ec *executionContext) _InputEdge_node(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.Logs, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2372,52 +2649,34 @@ func (ec *executionContext) _InputEdge_node(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Input)
+	res := resTmp.([]*model.Log)
 	fc.Result = res
-	return ec.marshalNInput2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐInput(ctx, field.Selections, res)
+	return ec.marshalNLog2ᚕᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐLogᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_InputEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Input_logs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "InputEdge",
+		Object:     "Input",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "index":
-				return ec.fieldContext_Input_index(ctx, field)
-			case "status":
-				return ec.fieldContext_Input_status(ctx, field)
-			case "msgSender":
-				return ec.fieldContext_Input_msgSender(ctx, field)
+			case "stream":
+				return ec.fieldContext_Log_stream(ctx, field)
+			case "line":
+				return ec.fieldContext_Log_line(ctx, field)
 			case "timestamp":
-				return ec.fieldContext_Input_timestamp(ctx, field)
-			case "blockNumber":
-				return ec.fieldContext_Input_blockNumber(ctx, field)
-			case "payload":
-				return ec.fieldContext_Input_payload(ctx, field)
-			case "voucher":
-				return ec.fieldContext_Input_voucher(ctx, field)
-			case "notice":
-				return ec.fieldContext_Input_notice(ctx, field)
-			case "report":
-				return ec.fieldContext_Input_report(ctx, field)
-			case "vouchers":
-				return ec.fieldContext_Input_vouchers(ctx, field)
-			case "notices":
-				return ec.fieldContext_Input_notices(ctx, field)
-			case "reports":
-				return ec.fieldContext_Input_reports(ctx, field)
+				return ec.fieldContext_Log_timestamp(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Input", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Log", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _InputEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Edge[*model.Input]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_InputEdge_cursor(ctx, field)
+func (ec *executionContext) _Input_violations(ctx context.Context, field graphql.CollectedField, obj *model.Input) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Input_violations(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2430,7 +2689,7 @@ func (ec *executionContext) _InputEdge_cursor(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor(), nil
+		return obj.Violations, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2442,16 +2701,16 @@ func (ec *executionContext) _InputEdge_cursor(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_InputEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Input_violations(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "InputEdge",
+		Object:     "Input",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -2460,8 +2719,8 @@ func (ec *executionContext) fieldContext_InputEdge_cursor(ctx context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Notice_index(ctx context.Context, field graphql.CollectedField, obj *model.Notice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notice_index(ctx, field)
+func (ec *executionContext) _InputConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Input]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_InputConnection_totalCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2474,7 +2733,7 @@ func (ec *executionContext) _Notice_index(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Index, nil
+		return obj.TotalCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2491,9 +2750,9 @@ func (ec *executionContext) _Notice_index(ctx context.Context, field graphql.Col
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notice_index(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_InputConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notice",
+		Object:     "InputConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2504,8 +2763,8 @@ func (ec *executionContext) fieldContext_Notice_index(ctx context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Notice_input(ctx context.Context, field graphql.CollectedField, obj *model.Notice) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Notice_input(ctx, field)
+func (ec *executionContext) _InputConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Input]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_InputConnection_edges(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -2518,7 +2777,7 @@ func (ec *executionContext) _Notice_input(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Notice().Input(rctx, obj)
+		return obj.Edges, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2530,25 +2789,697 @@ func (ec *executionContext) _Notice_input(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Input)
+	res := resTmp.([]*model.Edge[*model.Input])
 	fc.Result = res
-	return ec.marshalNInput2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐInput(ctx, field.Selections, res)
+	return ec.marshalNInputEdge2ᚕᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐEdgeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Notice_input(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_InputConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Notice",
+		Object:     "InputConnection",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "index":
-				return ec.fieldContext_Input_index(ctx, field)
-			case "status":
-				return ec.fieldContext_Input_status(ctx, field)
-			case "msgSender":
-				return ec.fieldContext_Input_msgSender(ctx, field)
+			case "node":
+				return ec.fieldContext_InputEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_InputEdge_cursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type InputEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InputConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Input]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_InputConnection_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_InputConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InputConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InputEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.Edge[*model.Input]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_InputEdge_node(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Input)
+	fc.Result = res
+	return ec.marshalNInput2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐInput(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_InputEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InputEdge",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "index":
+				return ec.fieldContext_Input_index(ctx, field)
+			case "status":
+				return ec.fieldContext_Input_status(ctx, field)
+			case "msgSender":
+				return ec.fieldContext_Input_msgSender(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_Input_timestamp(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_Input_blockNumber(ctx, field)
+			case "payload":
+				return ec.fieldContext_Input_payload(ctx, field)
+			case "voucher":
+				return ec.fieldContext_Input_voucher(ctx, field)
+			case "notice":
+				return ec.fieldContext_Input_notice(ctx, field)
+			case "report":
+				return ec.fieldContext_Input_report(ctx, field)
+			case "vouchers":
+				return ec.fieldContext_Input_vouchers(ctx, field)
+			case "notices":
+				return ec.fieldContext_Input_notices(ctx, field)
+			case "reports":
+				return ec.fieldContext_Input_reports(ctx, field)
+			case "exception":
+				return ec.fieldContext_Input_exception(ctx, field)
+			case "machineHalt":
+				return ec.fieldContext_Input_machineHalt(ctx, field)
+			case "usage":
+				return ec.fieldContext_Input_usage(ctx, field)
+			case "logs":
+				return ec.fieldContext_Input_logs(ctx, field)
+			case "violations":
+				return ec.fieldContext_Input_violations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Input", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InputEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Edge[*model.Input]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_InputEdge_cursor(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_InputEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InputEdge",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InputUsage_startedAt(ctx context.Context, field graphql.CollectedField, obj *model.InputUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_InputUsage_startedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.StartedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNBigInt2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_InputUsage_startedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InputUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InputUsage_finishedAt(ctx context.Context, field graphql.CollectedField, obj *model.InputUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_InputUsage_finishedAt(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FinishedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNBigInt2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_InputUsage_finishedAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InputUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _InputUsage_resources(ctx context.Context, field graphql.CollectedField, obj *model.InputUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_InputUsage_resources(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Resources, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.ResourceUsage)
+	fc.Result = res
+	return ec.marshalOResourceUsage2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐResourceUsage(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_InputUsage_resources(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "InputUsage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "cpuTime":
+				return ec.fieldContext_ResourceUsage_cpuTime(ctx, field)
+			case "peakMemory":
+				return ec.fieldContext_ResourceUsage_peakMemory(ctx, field)
+			case "readBytes":
+				return ec.fieldContext_ResourceUsage_readBytes(ctx, field)
+			case "writeBytes":
+				return ec.fieldContext_ResourceUsage_writeBytes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ResourceUsage", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_stream(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_stream(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Stream, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_stream(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_line(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_line(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Line, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_line(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Log_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.Log) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Log_timestamp(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNBigInt2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Log_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Log",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MachineHalt_exitCode(ctx context.Context, field graphql.CollectedField, obj *model.MachineHalt) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MachineHalt_exitCode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ExitCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MachineHalt_exitCode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MachineHalt",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MachineHalt_stderr(ctx context.Context, field graphql.CollectedField, obj *model.MachineHalt) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MachineHalt_stderr(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Stderr, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MachineHalt_stderr(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MachineHalt",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MachineHalt_reason(ctx context.Context, field graphql.CollectedField, obj *model.MachineHalt) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_MachineHalt_reason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Reason, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_MachineHalt_reason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MachineHalt",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Notice_index(ctx context.Context, field graphql.CollectedField, obj *model.Notice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notice_index(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Index, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Notice_index(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Notice",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Notice_input(ctx context.Context, field graphql.CollectedField, obj *model.Notice) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Notice_input(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Notice().Input(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Input)
+	fc.Result = res
+	return ec.marshalNInput2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐInput(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Notice_input(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Notice",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "index":
+				return ec.fieldContext_Input_index(ctx, field)
+			case "status":
+				return ec.fieldContext_Input_status(ctx, field)
+			case "msgSender":
+				return ec.fieldContext_Input_msgSender(ctx, field)
 			case "timestamp":
 				return ec.fieldContext_Input_timestamp(ctx, field)
 			case "blockNumber":
@@ -2567,6 +3498,16 @@ func (ec *executionContext) fieldContext_Notice_input(ctx context.Context, field
 				return ec.fieldContext_Input_notices(ctx, field)
 			case "reports":
 				return ec.fieldContext_Input_reports(ctx, field)
+			case "exception":
+				return ec.fieldContext_Input_exception(ctx, field)
+			case "machineHalt":
+				return ec.fieldContext_Input_machineHalt(ctx, field)
+			case "usage":
+				return ec.fieldContext_Input_usage(ctx, field)
+			case "logs":
+				return ec.fieldContext_Input_logs(ctx, field)
+			case "violations":
+				return ec.fieldContext_Input_violations(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Input", field.Name)
 		},
@@ -3318,23 +4259,287 @@ func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EndCursor, nil
+		return obj.EndCursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_endCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasNextPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HasPreviousPage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PageInfo",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingInput_msgSender(ctx context.Context, field graphql.CollectedField, obj *model.PendingInput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingInput_msgSender(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MsgSender, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PendingInput_msgSender(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PendingInput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingInput_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.PendingInput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingInput_timestamp(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Timestamp, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNBigInt2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PendingInput_timestamp(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PendingInput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingInput_blockNumber(ctx context.Context, field graphql.CollectedField, obj *model.PendingInput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingInput_blockNumber(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BlockNumber, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNBigInt2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_PendingInput_blockNumber(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PendingInput",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type BigInt does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PendingInput_payload(ctx context.Context, field graphql.CollectedField, obj *model.PendingInput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingInput_payload(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Payload, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_endCursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PendingInput_payload(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "PendingInput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3345,8 +4550,8 @@ func (ec *executionContext) fieldContext_PageInfo_endCursor(ctx context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+func (ec *executionContext) _PendingInput_releaseAt(ctx context.Context, field graphql.CollectedField, obj *model.PendingInput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingInput_releaseAt(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3359,7 +4564,7 @@ func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasNextPage, nil
+		return obj.ReleaseAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3371,26 +4576,26 @@ func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNBigInt2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PendingInput_releaseAt(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "PendingInput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type BigInt does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfo) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+func (ec *executionContext) _PendingInput_releaseBlock(ctx context.Context, field graphql.CollectedField, obj *model.PendingInput) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PendingInput_releaseBlock(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3403,7 +4608,7 @@ func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HasPreviousPage, nil
+		return obj.ReleaseBlock, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3415,19 +4620,19 @@ func (ec *executionContext) _PageInfo_hasPreviousPage(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNBigInt2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_PageInfo_hasPreviousPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PendingInput_releaseBlock(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfo",
+		Object:     "PendingInput",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type BigInt does not have child fields")
 		},
 	}
 	return fc, nil
@@ -3602,6 +4807,16 @@ func (ec *executionContext) fieldContext_Query_input(ctx context.Context, field
 				return ec.fieldContext_Input_notices(ctx, field)
 			case "reports":
 				return ec.fieldContext_Input_reports(ctx, field)
+			case "exception":
+				return ec.fieldContext_Input_exception(ctx, field)
+			case "machineHalt":
+				return ec.fieldContext_Input_machineHalt(ctx, field)
+			case "usage":
+				return ec.fieldContext_Input_usage(ctx, field)
+			case "logs":
+				return ec.fieldContext_Input_logs(ctx, field)
+			case "violations":
+				return ec.fieldContext_Input_violations(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Input", field.Name)
 		},
@@ -4067,6 +5282,64 @@ func (ec *executionContext) fieldContext_Query_reports(ctx context.Context, fiel
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_pendingInputs(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_pendingInputs(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().PendingInputs(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PendingInput)
+	fc.Result = res
+	return ec.marshalNPendingInput2ᚕᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐPendingInputᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Query_pendingInputs(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "msgSender":
+				return ec.fieldContext_PendingInput_msgSender(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_PendingInput_timestamp(ctx, field)
+			case "blockNumber":
+				return ec.fieldContext_PendingInput_blockNumber(ctx, field)
+			case "payload":
+				return ec.fieldContext_PendingInput_payload(ctx, field)
+			case "releaseAt":
+				return ec.fieldContext_PendingInput_releaseAt(ctx, field)
+			case "releaseBlock":
+				return ec.fieldContext_PendingInput_releaseBlock(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PendingInput", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	fc, err := ec.fieldContext_Query___type(ctx, field)
 	if err != nil {
@@ -4303,15 +5576,217 @@ func (ec *executionContext) fieldContext_Report_input(ctx context.Context, field
 				return ec.fieldContext_Input_notices(ctx, field)
 			case "reports":
 				return ec.fieldContext_Input_reports(ctx, field)
+			case "exception":
+				return ec.fieldContext_Input_exception(ctx, field)
+			case "machineHalt":
+				return ec.fieldContext_Input_machineHalt(ctx, field)
+			case "usage":
+				return ec.fieldContext_Input_usage(ctx, field)
+			case "logs":
+				return ec.fieldContext_Input_logs(ctx, field)
+			case "violations":
+				return ec.fieldContext_Input_violations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Input", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Report_payload(ctx context.Context, field graphql.CollectedField, obj *model.Report) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Report_payload(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Payload, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Report_payload(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Report",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReportConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Report]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReportConnection_totalCount(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReportConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReportConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReportConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Report]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReportConnection_edges(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Edge[*model.Report])
+	fc.Result = res
+	return ec.marshalNReportEdge2ᚕᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReportConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReportConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "node":
+				return ec.fieldContext_ReportEdge_node(ctx, field)
+			case "cursor":
+				return ec.fieldContext_ReportEdge_cursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ReportEdge", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ReportConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Report]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReportConnection_pageInfo(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ReportConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ReportConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "startCursor":
+				return ec.fieldContext_PageInfo_startCursor(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Input", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Report_payload(ctx context.Context, field graphql.CollectedField, obj *model.Report) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Report_payload(ctx, field)
+func (ec *executionContext) _ReportEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.Edge[*model.Report]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReportEdge_node(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4324,7 +5799,7 @@ func (ec *executionContext) _Report_payload(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Payload, nil
+		return obj.Node, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4336,26 +5811,34 @@ func (ec *executionContext) _Report_payload(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Report)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNReport2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐReport(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Report_payload(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ReportEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Report",
+		Object:     "ReportEdge",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "index":
+				return ec.fieldContext_Report_index(ctx, field)
+			case "input":
+				return ec.fieldContext_Report_input(ctx, field)
+			case "payload":
+				return ec.fieldContext_Report_payload(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Report", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReportConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Report]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReportConnection_totalCount(ctx, field)
+func (ec *executionContext) _ReportEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Edge[*model.Report]) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ReportEdge_cursor(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4368,7 +5851,7 @@ func (ec *executionContext) _ReportConnection_totalCount(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCount, nil
+		return obj.Cursor(), nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4380,26 +5863,26 @@ func (ec *executionContext) _ReportConnection_totalCount(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReportConnection_totalCount(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ReportEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReportConnection",
+		Object:     "ReportEdge",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReportConnection_edges(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Report]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReportConnection_edges(ctx, field)
+func (ec *executionContext) _ResourceUsage_cpuTime(ctx context.Context, field graphql.CollectedField, obj *model.ResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsage_cpuTime(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4412,7 +5895,7 @@ func (ec *executionContext) _ReportConnection_edges(ctx context.Context, field g
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Edges, nil
+		return obj.CPUTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4424,32 +5907,26 @@ func (ec *executionContext) _ReportConnection_edges(ctx context.Context, field g
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Edge[*model.Report])
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNReportEdge2ᚕᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐEdgeᚄ(ctx, field.Selections, res)
+	return ec.marshalNBigInt2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReportConnection_edges(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsage_cpuTime(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReportConnection",
+		Object:     "ResourceUsage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "node":
-				return ec.fieldContext_ReportEdge_node(ctx, field)
-			case "cursor":
-				return ec.fieldContext_ReportEdge_cursor(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ReportEdge", field.Name)
+			return nil, errors.New("field of type BigInt does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReportConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.Connection[*model.Report]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReportConnection_pageInfo(ctx, field)
+func (ec *executionContext) _ResourceUsage_peakMemory(ctx context.Context, field graphql.CollectedField, obj *model.ResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsage_peakMemory(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4462,7 +5939,7 @@ func (ec *executionContext) _ReportConnection_pageInfo(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PageInfo, nil
+		return obj.PeakMemory, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4474,36 +5951,26 @@ func (ec *executionContext) _ReportConnection_pageInfo(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.PageInfo)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐPageInfo(ctx, field.Selections, res)
+	return ec.marshalNBigInt2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReportConnection_pageInfo(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsage_peakMemory(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReportConnection",
+		Object:     "ResourceUsage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "startCursor":
-				return ec.fieldContext_PageInfo_startCursor(ctx, field)
-			case "endCursor":
-				return ec.fieldContext_PageInfo_endCursor(ctx, field)
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type BigInt does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReportEdge_node(ctx context.Context, field graphql.CollectedField, obj *model.Edge[*model.Report]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReportEdge_node(ctx, field)
+func (ec *executionContext) _ResourceUsage_readBytes(ctx context.Context, field graphql.CollectedField, obj *model.ResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsage_readBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4516,7 +5983,7 @@ func (ec *executionContext) _ReportEdge_node(ctx context.Context, field graphql.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Node, nil
+		return obj.ReadBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4528,34 +5995,26 @@ func (ec *executionContext) _ReportEdge_node(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Report)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNReport2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐReport(ctx, field.Selections, res)
+	return ec.marshalNBigInt2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReportEdge_node(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsage_readBytes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReportEdge",
+		Object:     "ResourceUsage",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "index":
-				return ec.fieldContext_Report_index(ctx, field)
-			case "input":
-				return ec.fieldContext_Report_input(ctx, field)
-			case "payload":
-				return ec.fieldContext_Report_payload(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Report", field.Name)
+			return nil, errors.New("field of type BigInt does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ReportEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *model.Edge[*model.Report]) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ReportEdge_cursor(ctx, field)
+func (ec *executionContext) _ResourceUsage_writeBytes(ctx context.Context, field graphql.CollectedField, obj *model.ResourceUsage) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ResourceUsage_writeBytes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4568,7 +6027,7 @@ func (ec *executionContext) _ReportEdge_cursor(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Cursor(), nil
+		return obj.WriteBytes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4582,17 +6041,17 @@ func (ec *executionContext) _ReportEdge_cursor(ctx context.Context, field graphq
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBigInt2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ReportEdge_cursor(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ResourceUsage_writeBytes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ReportEdge",
+		Object:     "ResourceUsage",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type BigInt does not have child fields")
 		},
 	}
 	return fc, nil
@@ -4705,6 +6164,16 @@ func (ec *executionContext) fieldContext_Voucher_input(ctx context.Context, fiel
 				return ec.fieldContext_Input_notices(ctx, field)
 			case "reports":
 				return ec.fieldContext_Input_reports(ctx, field)
+			case "exception":
+				return ec.fieldContext_Input_exception(ctx, field)
+			case "machineHalt":
+				return ec.fieldContext_Input_machineHalt(ctx, field)
+			case "usage":
+				return ec.fieldContext_Input_usage(ctx, field)
+			case "logs":
+				return ec.fieldContext_Input_logs(ctx, field)
+			case "violations":
+				return ec.fieldContext_Input_violations(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Input", field.Name)
 		},
@@ -7165,8 +8634,163 @@ func (ec *executionContext) _Input(ctx context.Context, sel ast.SelectionSet, ob
 				out.Values[i] = graphql.Null
 				continue
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "exception":
+			out.Values[i] = ec._Input_exception(ctx, field, obj)
+		case "machineHalt":
+			out.Values[i] = ec._Input_machineHalt(ctx, field, obj)
+		case "usage":
+			out.Values[i] = ec._Input_usage(ctx, field, obj)
+		case "logs":
+			out.Values[i] = ec._Input_logs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "violations":
+			out.Values[i] = ec._Input_violations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var inputConnectionImplementors = []string{"InputConnection"}
+
+func (ec *executionContext) _InputConnection(ctx context.Context, sel ast.SelectionSet, obj *model.Connection[*model.Input]) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, inputConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InputConnection")
+		case "totalCount":
+			out.Values[i] = ec._InputConnection_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "edges":
+			out.Values[i] = ec._InputConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._InputConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var inputEdgeImplementors = []string{"InputEdge"}
+
+func (ec *executionContext) _InputEdge(ctx context.Context, sel ast.SelectionSet, obj *model.Edge[*model.Input]) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, inputEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InputEdge")
+		case "node":
+			out.Values[i] = ec._InputEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cursor":
+			out.Values[i] = ec._InputEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var inputUsageImplementors = []string{"InputUsage"}
+
+func (ec *executionContext) _InputUsage(ctx context.Context, sel ast.SelectionSet, obj *model.InputUsage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, inputUsageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("InputUsage")
+		case "startedAt":
+			out.Values[i] = ec._InputUsage_startedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "finishedAt":
+			out.Values[i] = ec._InputUsage_finishedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "resources":
+			out.Values[i] = ec._InputUsage_resources(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7190,29 +8814,29 @@ func (ec *executionContext) _Input(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
-var inputConnectionImplementors = []string{"InputConnection"}
+var logImplementors = []string{"Log"}
 
-func (ec *executionContext) _InputConnection(ctx context.Context, sel ast.SelectionSet, obj *model.Connection[*model.Input]) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, inputConnectionImplementors)
+func (ec *executionContext) _Log(ctx context.Context, sel ast.SelectionSet, obj *model.Log) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, logImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("InputConnection")
-		case "totalCount":
-			out.Values[i] = ec._InputConnection_totalCount(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Log")
+		case "stream":
+			out.Values[i] = ec._Log_stream(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "edges":
-			out.Values[i] = ec._InputConnection_edges(ctx, field, obj)
+		case "line":
+			out.Values[i] = ec._Log_line(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "pageInfo":
-			out.Values[i] = ec._InputConnection_pageInfo(ctx, field, obj)
+		case "timestamp":
+			out.Values[i] = ec._Log_timestamp(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -7239,27 +8863,29 @@ func (ec *executionContext) _InputConnection(ctx context.Context, sel ast.Select
 	return out
 }
 
-var inputEdgeImplementors = []string{"InputEdge"}
+var machineHaltImplementors = []string{"MachineHalt"}
 
-func (ec *executionContext) _InputEdge(ctx context.Context, sel ast.SelectionSet, obj *model.Edge[*model.Input]) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, inputEdgeImplementors)
+func (ec *executionContext) _MachineHalt(ctx context.Context, sel ast.SelectionSet, obj *model.MachineHalt) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, machineHaltImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("InputEdge")
-		case "node":
-			out.Values[i] = ec._InputEdge_node(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("MachineHalt")
+		case "exitCode":
+			out.Values[i] = ec._MachineHalt_exitCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "cursor":
-			out.Values[i] = ec._InputEdge_cursor(ctx, field, obj)
+		case "stderr":
+			out.Values[i] = ec._MachineHalt_stderr(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "reason":
+			out.Values[i] = ec._MachineHalt_reason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7580,6 +9206,70 @@ func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var pendingInputImplementors = []string{"PendingInput"}
+
+func (ec *executionContext) _PendingInput(ctx context.Context, sel ast.SelectionSet, obj *model.PendingInput) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pendingInputImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PendingInput")
+		case "msgSender":
+			out.Values[i] = ec._PendingInput_msgSender(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamp":
+			out.Values[i] = ec._PendingInput_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "blockNumber":
+			out.Values[i] = ec._PendingInput_blockNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "payload":
+			out.Values[i] = ec._PendingInput_payload(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "releaseAt":
+			out.Values[i] = ec._PendingInput_releaseAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "releaseBlock":
+			out.Values[i] = ec._PendingInput_releaseBlock(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var proofImplementors = []string{"Proof"}
 
 func (ec *executionContext) _Proof(ctx context.Context, sel ast.SelectionSet, obj *model.Proof) graphql.Marshaler {
@@ -7818,6 +9508,28 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "pendingInputs":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_pendingInputs(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "__type":
 			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
@@ -8023,6 +9735,60 @@ func (ec *executionContext) _ReportEdge(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
+var resourceUsageImplementors = []string{"ResourceUsage"}
+
+func (ec *executionContext) _ResourceUsage(ctx context.Context, sel ast.SelectionSet, obj *model.ResourceUsage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, resourceUsageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ResourceUsage")
+		case "cpuTime":
+			out.Values[i] = ec._ResourceUsage_cpuTime(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "peakMemory":
+			out.Values[i] = ec._ResourceUsage_peakMemory(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "readBytes":
+			out.Values[i] = ec._ResourceUsage_readBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "writeBytes":
+			out.Values[i] = ec._ResourceUsage_writeBytes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var voucherImplementors = []string{"Voucher"}
 
 func (ec *executionContext) _Voucher(ctx context.Context, sel ast.SelectionSet, obj *model.Voucher) graphql.Marshaler {
@@ -8666,6 +10432,60 @@ func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.Selecti
 	return res
 }
 
+func (ec *executionContext) marshalNLog2ᚕᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐLogᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Log) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNLog2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐLog(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNLog2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐLog(ctx context.Context, sel ast.SelectionSet, v *model.Log) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Log(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNNotice2githubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐNotice(ctx context.Context, sel ast.SelectionSet, v model.Notice) graphql.Marshaler {
 	return ec._Notice(ctx, sel, &v)
 }
@@ -8768,6 +10588,60 @@ func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋgligneulᚋnonodo
 	return ec._PageInfo(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNPendingInput2ᚕᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐPendingInputᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PendingInput) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPendingInput2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐPendingInput(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNPendingInput2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐPendingInput(ctx context.Context, sel ast.SelectionSet, v *model.PendingInput) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PendingInput(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNReport2githubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐReport(ctx context.Context, sel ast.SelectionSet, v model.Report) graphql.Marshaler {
 	return ec._Report(ctx, sel, &v)
 }
@@ -9266,6 +11140,13 @@ func (ec *executionContext) unmarshalOInputFilter2ᚖgithubᚗcomᚋgligneulᚋn
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOInputUsage2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐInputUsage(ctx context.Context, sel ast.SelectionSet, v *model.InputUsage) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._InputUsage(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
 	if v == nil {
 		return nil, nil
@@ -9282,6 +11163,13 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return res
 }
 
+func (ec *executionContext) marshalOMachineHalt2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐMachineHalt(ctx context.Context, sel ast.SelectionSet, v *model.MachineHalt) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._MachineHalt(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOProof2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐProof(ctx context.Context, sel ast.SelectionSet, v *model.Proof) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -9289,6 +11177,13 @@ func (ec *executionContext) marshalOProof2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋ
 	return ec._Proof(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOResourceUsage2ᚖgithubᚗcomᚋgligneulᚋnonodoᚋinternalᚋreaderᚋmodelᚐResourceUsage(ctx context.Context, sel ast.SelectionSet, v *model.ResourceUsage) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ResourceUsage(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v interface{}) (*string, error) {
 	if v == nil {
 		return nil, nil
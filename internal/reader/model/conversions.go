@@ -24,6 +24,8 @@ func convertCompletionStatus(status model.CompletionStatus) CompletionStatus {
 		return CompletionStatusRejected
 	case model.CompletionStatusException:
 		return CompletionStatusException
+	case model.CompletionStatusMachineHalted:
+		return CompletionStatusMachineHalted
 	default:
 		panic("invalid completion status")
 	}
@@ -37,6 +39,77 @@ func convertInput(input model.AdvanceInput) *Input {
 		Timestamp:   fmt.Sprint(input.Timestamp.Unix()),
 		BlockNumber: fmt.Sprint(input.BlockNumber),
 		Payload:     hexutil.Encode(input.Payload),
+		Exception:   convertException(input),
+		MachineHalt: convertMachineHalt(input.MachineHalt),
+		Usage:       convertInputUsage(input.Usage),
+		Logs:        convertLogs(input.Logs),
+		Violations:  append([]string{}, input.Violations...),
+	}
+}
+
+func convertException(input model.AdvanceInput) *string {
+	if input.Status != model.CompletionStatusException {
+		return nil
+	}
+	exception := hexutil.Encode(input.Exception)
+	return &exception
+}
+
+func convertMachineHalt(halt *model.MachineHalt) *MachineHalt {
+	if halt == nil {
+		return nil
+	}
+	var reason *string
+	if halt.Reason != "" {
+		reason = &halt.Reason
+	}
+	return &MachineHalt{
+		ExitCode: halt.ExitCode,
+		Stderr:   halt.Stderr,
+		Reason:   reason,
+	}
+}
+
+func convertInputUsage(usage *model.InputUsage) *InputUsage {
+	if usage == nil {
+		return nil
+	}
+	var resources *ResourceUsage
+	if usage.Resources != nil {
+		resources = &ResourceUsage{
+			CPUTime:    fmt.Sprint(usage.Resources.CPUTime.Milliseconds()),
+			PeakMemory: fmt.Sprint(usage.Resources.PeakMemory),
+			ReadBytes:  fmt.Sprint(usage.Resources.ReadBytes),
+			WriteBytes: fmt.Sprint(usage.Resources.WriteBytes),
+		}
+	}
+	return &InputUsage{
+		StartedAt:  fmt.Sprint(usage.StartedAt.UnixMilli()),
+		FinishedAt: fmt.Sprint(usage.FinishedAt.UnixMilli()),
+		Resources:  resources,
+	}
+}
+
+func convertLogs(logs []model.Log) []*Log {
+	converted := make([]*Log, len(logs))
+	for i, log := range logs {
+		converted[i] = &Log{
+			Stream:    log.Stream,
+			Line:      log.Line,
+			Timestamp: fmt.Sprint(log.Timestamp.UnixMilli()),
+		}
+	}
+	return converted
+}
+
+func convertPendingInput(input model.PendingInput) *PendingInput {
+	return &PendingInput{
+		MsgSender:    input.MsgSender.String(),
+		Timestamp:    fmt.Sprint(input.Timestamp.Unix()),
+		BlockNumber:  fmt.Sprint(input.BlockNumber),
+		Payload:      hexutil.Encode(input.Payload),
+		ReleaseAt:    fmt.Sprint(input.ReleaseAt.UnixMilli()),
+		ReleaseBlock: fmt.Sprint(input.ReleaseBlock),
 	}
 }
 
@@ -16,6 +16,36 @@ type InputFilter struct {
 	IndexGreaterThan *int `json:"indexGreaterThan,omitempty"`
 }
 
+// Information about how the application processed an input
+type InputUsage struct {
+	// Time when the application received the input, in milliseconds since the Unix epoch
+	StartedAt string `json:"startedAt"`
+	// Time when the application finished processing the input, in milliseconds since the Unix epoch
+	FinishedAt string `json:"finishedAt"`
+	// Resources used by the application; null if nonodo didn't measure them
+	Resources *ResourceUsage `json:"resources,omitempty"`
+}
+
+// Line the application wrote while processing an input
+type Log struct {
+	// Stream the application wrote the line to: stdout or stderr
+	Stream string `json:"stream"`
+	// Content of the line
+	Line string `json:"line"`
+	// Time when nonodo captured the line, in milliseconds since the Unix epoch
+	Timestamp string `json:"timestamp"`
+}
+
+// Information about the application exit that halted the machine
+type MachineHalt struct {
+	// Exit code of the application; -1 if it was terminated by a signal
+	ExitCode int `json:"exitCode"`
+	// Last lines the application wrote to stderr
+	Stderr string `json:"stderr"`
+	// Reason nonodo stopped the application if it exceeded a resource limit
+	Reason *string `json:"reason,omitempty"`
+}
+
 // Validity proof for an output
 type OutputValidityProof struct {
 	// Local input index within the context of the related epoch
@@ -48,6 +78,22 @@ type PageInfo struct {
 	HasPreviousPage bool `json:"hasPreviousPage"`
 }
 
+// Input from the base layer that nonodo holds before adding it to the inputs, emulating the latency of a test net
+type PendingInput struct {
+	// Address responsible for submitting the input
+	MsgSender string `json:"msgSender"`
+	// Timestamp associated with the input submission, as defined by the base layer's block in which it was recorded
+	Timestamp string `json:"timestamp"`
+	// Number of the base layer block in which the input was recorded
+	BlockNumber string `json:"blockNumber"`
+	// Input payload in Ethereum hex binary format, starting with '0x'
+	Payload string `json:"payload"`
+	// Time when nonodo releases the input, in milliseconds since the Unix epoch
+	ReleaseAt string `json:"releaseAt"`
+	// Number of the base layer block that releases the input
+	ReleaseBlock string `json:"releaseBlock"`
+}
+
 // Data that can be used as proof to validate notices and execute vouchers on the base layer blockchain
 type Proof struct {
 	// Validity proof for an output
@@ -56,6 +102,18 @@ type Proof struct {
 	Context string `json:"context"`
 }
 
+// Resources used by the application to process an input
+type ResourceUsage struct {
+	// CPU time used by the application processes, in milliseconds
+	CPUTime string `json:"cpuTime"`
+	// Peak resident memory of the application processes, in bytes
+	PeakMemory string `json:"peakMemory"`
+	// Bytes the application processes read from the storage
+	ReadBytes string `json:"readBytes"`
+	// Bytes the application processes wrote to the storage
+	WriteBytes string `json:"writeBytes"`
+}
+
 type CompletionStatus string
 
 const (
@@ -119,3 +119,12 @@ func (m *ModelWrapper) GetReports(
 	}
 	return newConnection(offset, total, convNodes), nil
 }
+
+func (m *ModelWrapper) GetPendingInputs() []*PendingInput {
+	inputs := m.model.GetPendingInputs()
+	converted := make([]*PendingInput, len(inputs))
+	for i := range inputs {
+		converted[i] = convertPendingInput(inputs[i])
+	}
+	return converted
+}
@@ -18,6 +18,16 @@ type Input struct {
 	BlockNumber string `json:"blockNumber"`
 	// Input payload in Ethereum hex binary format, starting with '0x'
 	Payload string `json:"payload"`
+	// Exception payload in Ethereum hex binary format if the application raised an exception
+	Exception *string `json:"exception,omitempty"`
+	// Information about the application exit if the machine halted while processing the input
+	MachineHalt *MachineHalt `json:"machineHalt,omitempty"`
+	// Information about how the application processed the input; null if it wasn't processed
+	Usage *InputUsage `json:"usage,omitempty"`
+	// Lines the application wrote to stdout and stderr while processing the input
+	Logs []*Log `json:"logs"`
+	// Conformance violations of the rollup API found while processing the input in strict mode
+	Violations []string `json:"violations"`
 }
 
 // Representation of a transaction that can be carried out on the base layer blockchain, such as a
@@ -86,6 +86,11 @@ func (r *queryResolver) Reports(ctx context.Context, first *int, last *int, afte
 	return r.model.GetReports(first, last, after, before, nil)
 }
 
+// PendingInputs is the resolver for the pendingInputs field.
+func (r *queryResolver) PendingInputs(ctx context.Context) ([]*model.PendingInput, error) {
+	return r.model.GetPendingInputs(), nil
+}
+
 // Input is the resolver for the input field.
 func (r *reportResolver) Input(ctx context.Context, obj *model.Report) (*model.Input, error) {
 	return r.model.GetInput(obj.InputIndex)
@@ -21,6 +21,196 @@ const (
 	CompletionStatusPayloadLengthLimitExceeded CompletionStatus = "PAYLOAD_LENGTH_LIMIT_EXCEEDED"
 )
 
+// HistoryInputsInputConnection includes the requested fields of the GraphQL type InputConnection.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type HistoryInputsInputConnection struct {
+	// Pagination metadata
+	PageInfo HistoryInputsInputConnectionPageInfo `json:"pageInfo"`
+	// Pagination entries returned for the current page
+	Edges []HistoryInputsInputConnectionEdgesInputEdge `json:"edges"`
+}
+
+// GetPageInfo returns HistoryInputsInputConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnection) GetPageInfo() HistoryInputsInputConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetEdges returns HistoryInputsInputConnection.Edges, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnection) GetEdges() []HistoryInputsInputConnectionEdgesInputEdge {
+	return v.Edges
+}
+
+// HistoryInputsInputConnectionEdgesInputEdge includes the requested fields of the GraphQL type InputEdge.
+// The GraphQL type's documentation follows.
+//
+// Pagination entry
+type HistoryInputsInputConnectionEdgesInputEdge struct {
+	// Node instance
+	Node HistoryInputsInputConnectionEdgesInputEdgeNodeInput `json:"node"`
+}
+
+// GetNode returns HistoryInputsInputConnectionEdgesInputEdge.Node, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionEdgesInputEdge) GetNode() HistoryInputsInputConnectionEdgesInputEdgeNodeInput {
+	return v.Node
+}
+
+// HistoryInputsInputConnectionEdgesInputEdgeNodeInput includes the requested fields of the GraphQL type Input.
+// The GraphQL type's documentation follows.
+//
+// Request submitted to the application to advance its state
+type HistoryInputsInputConnectionEdgesInputEdgeNodeInput struct {
+	// Input index starting from genesis
+	Index int `json:"index"`
+	// Status of the input
+	Status CompletionStatus `json:"status"`
+	// Address responsible for submitting the input
+	MsgSender string `json:"msgSender"`
+	// Timestamp associated with the input submission, as defined by the base layer's block in which it was recorded
+	Timestamp string `json:"timestamp"`
+	// Number of the base layer block in which the input was recorded
+	BlockNumber string `json:"blockNumber"`
+	// Input payload in Ethereum hex binary format, starting with '0x'
+	Payload string `json:"payload"`
+	// Get notices from this particular input with support for pagination
+	Notices NoticePage `json:"notices"`
+	// Get vouchers from this particular input with support for pagination
+	Vouchers VoucherPage `json:"vouchers"`
+	// Get reports from this particular input with support for pagination
+	Reports ReportPage `json:"reports"`
+}
+
+// GetIndex returns HistoryInputsInputConnectionEdgesInputEdgeNodeInput.Index, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionEdgesInputEdgeNodeInput) GetIndex() int { return v.Index }
+
+// GetStatus returns HistoryInputsInputConnectionEdgesInputEdgeNodeInput.Status, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionEdgesInputEdgeNodeInput) GetStatus() CompletionStatus {
+	return v.Status
+}
+
+// GetMsgSender returns HistoryInputsInputConnectionEdgesInputEdgeNodeInput.MsgSender, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionEdgesInputEdgeNodeInput) GetMsgSender() string {
+	return v.MsgSender
+}
+
+// GetTimestamp returns HistoryInputsInputConnectionEdgesInputEdgeNodeInput.Timestamp, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionEdgesInputEdgeNodeInput) GetTimestamp() string {
+	return v.Timestamp
+}
+
+// GetBlockNumber returns HistoryInputsInputConnectionEdgesInputEdgeNodeInput.BlockNumber, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionEdgesInputEdgeNodeInput) GetBlockNumber() string {
+	return v.BlockNumber
+}
+
+// GetPayload returns HistoryInputsInputConnectionEdgesInputEdgeNodeInput.Payload, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionEdgesInputEdgeNodeInput) GetPayload() string { return v.Payload }
+
+// GetNotices returns HistoryInputsInputConnectionEdgesInputEdgeNodeInput.Notices, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionEdgesInputEdgeNodeInput) GetNotices() NoticePage {
+	return v.Notices
+}
+
+// GetVouchers returns HistoryInputsInputConnectionEdgesInputEdgeNodeInput.Vouchers, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionEdgesInputEdgeNodeInput) GetVouchers() VoucherPage {
+	return v.Vouchers
+}
+
+// GetReports returns HistoryInputsInputConnectionEdgesInputEdgeNodeInput.Reports, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionEdgesInputEdgeNodeInput) GetReports() ReportPage {
+	return v.Reports
+}
+
+// HistoryInputsInputConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type HistoryInputsInputConnectionPageInfo struct {
+	// Cursor pointing to the last entry of the page
+	EndCursor string `json:"endCursor"`
+	// Indicates if there are additional entries after the end curs
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetEndCursor returns HistoryInputsInputConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionPageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetHasNextPage returns HistoryInputsInputConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *HistoryInputsInputConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// HistoryResponse is returned by History on success.
+type HistoryResponse struct {
+	// Get inputs with support for pagination
+	Inputs HistoryInputsInputConnection `json:"inputs"`
+}
+
+// GetInputs returns HistoryResponse.Inputs, and is useful for accessing the field via an interface.
+func (v *HistoryResponse) GetInputs() HistoryInputsInputConnection { return v.Inputs }
+
+// InputExceptionInput includes the requested fields of the GraphQL type Input.
+// The GraphQL type's documentation follows.
+//
+// Request submitted to the application to advance its state
+type InputExceptionInput struct {
+	// Exception payload in Ethereum hex binary format if the application raised an exception
+	Exception string `json:"exception"`
+}
+
+// GetException returns InputExceptionInput.Exception, and is useful for accessing the field via an interface.
+func (v *InputExceptionInput) GetException() string { return v.Exception }
+
+// InputExceptionResponse is returned by InputException on success.
+type InputExceptionResponse struct {
+	// Get input based on its identifier
+	Input InputExceptionInput `json:"input"`
+}
+
+// GetInput returns InputExceptionResponse.Input, and is useful for accessing the field via an interface.
+func (v *InputExceptionResponse) GetInput() InputExceptionInput { return v.Input }
+
+// InputNoticesInput includes the requested fields of the GraphQL type Input.
+// The GraphQL type's documentation follows.
+//
+// Request submitted to the application to advance its state
+type InputNoticesInput struct {
+	// Get notices from this particular input with support for pagination
+	Notices NoticePage `json:"notices"`
+}
+
+// GetNotices returns InputNoticesInput.Notices, and is useful for accessing the field via an interface.
+func (v *InputNoticesInput) GetNotices() NoticePage { return v.Notices }
+
+// InputNoticesResponse is returned by InputNotices on success.
+type InputNoticesResponse struct {
+	// Get input based on its identifier
+	Input InputNoticesInput `json:"input"`
+}
+
+// GetInput returns InputNoticesResponse.Input, and is useful for accessing the field via an interface.
+func (v *InputNoticesResponse) GetInput() InputNoticesInput { return v.Input }
+
+// InputReportsInput includes the requested fields of the GraphQL type Input.
+// The GraphQL type's documentation follows.
+//
+// Request submitted to the application to advance its state
+type InputReportsInput struct {
+	// Get reports from this particular input with support for pagination
+	Reports ReportPage `json:"reports"`
+}
+
+// GetReports returns InputReportsInput.Reports, and is useful for accessing the field via an interface.
+func (v *InputReportsInput) GetReports() ReportPage { return v.Reports }
+
+// InputReportsResponse is returned by InputReports on success.
+type InputReportsResponse struct {
+	// Get input based on its identifier
+	Input InputReportsInput `json:"input"`
+}
+
+// GetInput returns InputReportsResponse.Input, and is useful for accessing the field via an interface.
+func (v *InputReportsResponse) GetInput() InputReportsInput { return v.Input }
+
 // InputStatusInput includes the requested fields of the GraphQL type Input.
 // The GraphQL type's documentation follows.
 //
@@ -42,6 +232,143 @@ type InputStatusResponse struct {
 // GetInput returns InputStatusResponse.Input, and is useful for accessing the field via an interface.
 func (v *InputStatusResponse) GetInput() InputStatusInput { return v.Input }
 
+// InputVouchersInput includes the requested fields of the GraphQL type Input.
+// The GraphQL type's documentation follows.
+//
+// Request submitted to the application to advance its state
+type InputVouchersInput struct {
+	// Get vouchers from this particular input with support for pagination
+	Vouchers VoucherPage `json:"vouchers"`
+}
+
+// GetVouchers returns InputVouchersInput.Vouchers, and is useful for accessing the field via an interface.
+func (v *InputVouchersInput) GetVouchers() VoucherPage { return v.Vouchers }
+
+// InputVouchersResponse is returned by InputVouchers on success.
+type InputVouchersResponse struct {
+	// Get input based on its identifier
+	Input InputVouchersInput `json:"input"`
+}
+
+// GetInput returns InputVouchersResponse.Input, and is useful for accessing the field via an interface.
+func (v *InputVouchersResponse) GetInput() InputVouchersInput { return v.Input }
+
+// NoticePage includes the requested fields of the GraphQL type NoticeConnection.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type NoticePage struct {
+	// Pagination metadata
+	PageInfo NoticePagePageInfo `json:"pageInfo"`
+	// Pagination entries returned for the current page
+	Edges []NoticePageEdgesNoticeEdge `json:"edges"`
+}
+
+// GetPageInfo returns NoticePage.PageInfo, and is useful for accessing the field via an interface.
+func (v *NoticePage) GetPageInfo() NoticePagePageInfo { return v.PageInfo }
+
+// GetEdges returns NoticePage.Edges, and is useful for accessing the field via an interface.
+func (v *NoticePage) GetEdges() []NoticePageEdgesNoticeEdge { return v.Edges }
+
+// NoticePageEdgesNoticeEdge includes the requested fields of the GraphQL type NoticeEdge.
+// The GraphQL type's documentation follows.
+//
+// Pagination entry
+type NoticePageEdgesNoticeEdge struct {
+	// Node instance
+	Node NoticePageEdgesNoticeEdgeNodeNotice `json:"node"`
+}
+
+// GetNode returns NoticePageEdgesNoticeEdge.Node, and is useful for accessing the field via an interface.
+func (v *NoticePageEdgesNoticeEdge) GetNode() NoticePageEdgesNoticeEdgeNodeNotice { return v.Node }
+
+// NoticePageEdgesNoticeEdgeNodeNotice includes the requested fields of the GraphQL type Notice.
+// The GraphQL type's documentation follows.
+//
+// Informational statement that can be validated in the base layer blockchain
+type NoticePageEdgesNoticeEdgeNodeNotice struct {
+	// Notice data as a payload in Ethereum hex binary format, starting with '0x'
+	Payload string `json:"payload"`
+}
+
+// GetPayload returns NoticePageEdgesNoticeEdgeNodeNotice.Payload, and is useful for accessing the field via an interface.
+func (v *NoticePageEdgesNoticeEdgeNodeNotice) GetPayload() string { return v.Payload }
+
+// NoticePagePageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type NoticePagePageInfo struct {
+	// Cursor pointing to the last entry of the page
+	EndCursor string `json:"endCursor"`
+	// Indicates if there are additional entries after the end curs
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetEndCursor returns NoticePagePageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *NoticePagePageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetHasNextPage returns NoticePagePageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *NoticePagePageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// ReportPage includes the requested fields of the GraphQL type ReportConnection.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type ReportPage struct {
+	// Pagination metadata
+	PageInfo ReportPagePageInfo `json:"pageInfo"`
+	// Pagination entries returned for the current page
+	Edges []ReportPageEdgesReportEdge `json:"edges"`
+}
+
+// GetPageInfo returns ReportPage.PageInfo, and is useful for accessing the field via an interface.
+func (v *ReportPage) GetPageInfo() ReportPagePageInfo { return v.PageInfo }
+
+// GetEdges returns ReportPage.Edges, and is useful for accessing the field via an interface.
+func (v *ReportPage) GetEdges() []ReportPageEdgesReportEdge { return v.Edges }
+
+// ReportPageEdgesReportEdge includes the requested fields of the GraphQL type ReportEdge.
+// The GraphQL type's documentation follows.
+//
+// Pagination entry
+type ReportPageEdgesReportEdge struct {
+	// Node instance
+	Node ReportPageEdgesReportEdgeNodeReport `json:"node"`
+}
+
+// GetNode returns ReportPageEdgesReportEdge.Node, and is useful for accessing the field via an interface.
+func (v *ReportPageEdgesReportEdge) GetNode() ReportPageEdgesReportEdgeNodeReport { return v.Node }
+
+// ReportPageEdgesReportEdgeNodeReport includes the requested fields of the GraphQL type Report.
+// The GraphQL type's documentation follows.
+//
+// Application log or diagnostic information
+type ReportPageEdgesReportEdgeNodeReport struct {
+	// Report data as a payload in Ethereum hex binary format, starting with '0x'
+	Payload string `json:"payload"`
+}
+
+// GetPayload returns ReportPageEdgesReportEdgeNodeReport.Payload, and is useful for accessing the field via an interface.
+func (v *ReportPageEdgesReportEdgeNodeReport) GetPayload() string { return v.Payload }
+
+// ReportPagePageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type ReportPagePageInfo struct {
+	// Cursor pointing to the last entry of the page
+	EndCursor string `json:"endCursor"`
+	// Indicates if there are additional entries after the end curs
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetEndCursor returns ReportPagePageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ReportPagePageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetHasNextPage returns ReportPagePageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ReportPagePageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
 // StateInputsInputConnection includes the requested fields of the GraphQL type InputConnection.
 // The GraphQL type's documentation follows.
 //
@@ -295,6 +622,125 @@ type StateResponse struct {
 // GetInputs returns StateResponse.Inputs, and is useful for accessing the field via an interface.
 func (v *StateResponse) GetInputs() StateInputsInputConnection { return v.Inputs }
 
+// VoucherPage includes the requested fields of the GraphQL type VoucherConnection.
+// The GraphQL type's documentation follows.
+//
+// Pagination result
+type VoucherPage struct {
+	// Pagination metadata
+	PageInfo VoucherPagePageInfo `json:"pageInfo"`
+	// Pagination entries returned for the current page
+	Edges []VoucherPageEdgesVoucherEdge `json:"edges"`
+}
+
+// GetPageInfo returns VoucherPage.PageInfo, and is useful for accessing the field via an interface.
+func (v *VoucherPage) GetPageInfo() VoucherPagePageInfo { return v.PageInfo }
+
+// GetEdges returns VoucherPage.Edges, and is useful for accessing the field via an interface.
+func (v *VoucherPage) GetEdges() []VoucherPageEdgesVoucherEdge { return v.Edges }
+
+// VoucherPageEdgesVoucherEdge includes the requested fields of the GraphQL type VoucherEdge.
+// The GraphQL type's documentation follows.
+//
+// Pagination entry
+type VoucherPageEdgesVoucherEdge struct {
+	// Node instance
+	Node VoucherPageEdgesVoucherEdgeNodeVoucher `json:"node"`
+}
+
+// GetNode returns VoucherPageEdgesVoucherEdge.Node, and is useful for accessing the field via an interface.
+func (v *VoucherPageEdgesVoucherEdge) GetNode() VoucherPageEdgesVoucherEdgeNodeVoucher { return v.Node }
+
+// VoucherPageEdgesVoucherEdgeNodeVoucher includes the requested fields of the GraphQL type Voucher.
+// The GraphQL type's documentation follows.
+//
+// Representation of a transaction that can be carried out on the base layer blockchain, such as a transfer of assets
+type VoucherPageEdgesVoucherEdgeNodeVoucher struct {
+	// Transaction payload in Ethereum hex binary format, starting with '0x'
+	Payload string `json:"payload"`
+	// Transaction destination address in Ethereum hex binary format (20 bytes), starting with '0x'
+	Destination string `json:"destination"`
+}
+
+// GetPayload returns VoucherPageEdgesVoucherEdgeNodeVoucher.Payload, and is useful for accessing the field via an interface.
+func (v *VoucherPageEdgesVoucherEdgeNodeVoucher) GetPayload() string { return v.Payload }
+
+// GetDestination returns VoucherPageEdgesVoucherEdgeNodeVoucher.Destination, and is useful for accessing the field via an interface.
+func (v *VoucherPageEdgesVoucherEdgeNodeVoucher) GetDestination() string { return v.Destination }
+
+// VoucherPagePageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Page metadata for the cursor-based Connection pagination pattern
+type VoucherPagePageInfo struct {
+	// Cursor pointing to the last entry of the page
+	EndCursor string `json:"endCursor"`
+	// Indicates if there are additional entries after the end curs
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetEndCursor returns VoucherPagePageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *VoucherPagePageInfo) GetEndCursor() string { return v.EndCursor }
+
+// GetHasNextPage returns VoucherPagePageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *VoucherPagePageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// __HistoryInput is used internally by genqlient
+type __HistoryInput struct {
+	First   int    `json:"first"`
+	Outputs int    `json:"outputs"`
+	After   string `json:"after,omitempty"`
+}
+
+// GetFirst returns __HistoryInput.First, and is useful for accessing the field via an interface.
+func (v *__HistoryInput) GetFirst() int { return v.First }
+
+// GetOutputs returns __HistoryInput.Outputs, and is useful for accessing the field via an interface.
+func (v *__HistoryInput) GetOutputs() int { return v.Outputs }
+
+// GetAfter returns __HistoryInput.After, and is useful for accessing the field via an interface.
+func (v *__HistoryInput) GetAfter() string { return v.After }
+
+// __InputExceptionInput is used internally by genqlient
+type __InputExceptionInput struct {
+	Index int `json:"index"`
+}
+
+// GetIndex returns __InputExceptionInput.Index, and is useful for accessing the field via an interface.
+func (v *__InputExceptionInput) GetIndex() int { return v.Index }
+
+// __InputNoticesInput is used internally by genqlient
+type __InputNoticesInput struct {
+	Index int    `json:"index"`
+	First int    `json:"first"`
+	After string `json:"after"`
+}
+
+// GetIndex returns __InputNoticesInput.Index, and is useful for accessing the field via an interface.
+func (v *__InputNoticesInput) GetIndex() int { return v.Index }
+
+// GetFirst returns __InputNoticesInput.First, and is useful for accessing the field via an interface.
+func (v *__InputNoticesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __InputNoticesInput.After, and is useful for accessing the field via an interface.
+func (v *__InputNoticesInput) GetAfter() string { return v.After }
+
+// __InputReportsInput is used internally by genqlient
+type __InputReportsInput struct {
+	Index int    `json:"index"`
+	First int    `json:"first"`
+	After string `json:"after"`
+}
+
+// GetIndex returns __InputReportsInput.Index, and is useful for accessing the field via an interface.
+func (v *__InputReportsInput) GetIndex() int { return v.Index }
+
+// GetFirst returns __InputReportsInput.First, and is useful for accessing the field via an interface.
+func (v *__InputReportsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __InputReportsInput.After, and is useful for accessing the field via an interface.
+func (v *__InputReportsInput) GetAfter() string { return v.After }
+
 // __InputStatusInput is used internally by genqlient
 type __InputStatusInput struct {
 	Index int `json:"index"`
@@ -303,6 +749,245 @@ type __InputStatusInput struct {
 // GetIndex returns __InputStatusInput.Index, and is useful for accessing the field via an interface.
 func (v *__InputStatusInput) GetIndex() int { return v.Index }
 
+// __InputVouchersInput is used internally by genqlient
+type __InputVouchersInput struct {
+	Index int    `json:"index"`
+	First int    `json:"first"`
+	After string `json:"after"`
+}
+
+// GetIndex returns __InputVouchersInput.Index, and is useful for accessing the field via an interface.
+func (v *__InputVouchersInput) GetIndex() int { return v.Index }
+
+// GetFirst returns __InputVouchersInput.First, and is useful for accessing the field via an interface.
+func (v *__InputVouchersInput) GetFirst() int { return v.First }
+
+// GetAfter returns __InputVouchersInput.After, and is useful for accessing the field via an interface.
+func (v *__InputVouchersInput) GetAfter() string { return v.After }
+
+// The query or mutation executed by History.
+const History_Operation = `
+query History ($first: Int!, $outputs: Int!, $after: String) {
+	inputs(first: $first, after: $after) {
+		pageInfo {
+			endCursor
+			hasNextPage
+		}
+		edges {
+			node {
+				index
+				status
+				msgSender
+				timestamp
+				blockNumber
+				payload
+				notices(first: $outputs) {
+					pageInfo {
+						endCursor
+						hasNextPage
+					}
+					edges {
+						node {
+							payload
+						}
+					}
+				}
+				vouchers(first: $outputs) {
+					pageInfo {
+						endCursor
+						hasNextPage
+					}
+					edges {
+						node {
+							payload
+							destination
+						}
+					}
+				}
+				reports(first: $outputs) {
+					pageInfo {
+						endCursor
+						hasNextPage
+					}
+					edges {
+						node {
+							payload
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`
+
+// Get a page of inputs with the first page of their outputs.
+func History(
+	ctx context.Context,
+	client graphql.Client,
+	first int,
+	outputs int,
+	after string,
+) (*HistoryResponse, error) {
+	req := &graphql.Request{
+		OpName: "History",
+		Query:  History_Operation,
+		Variables: &__HistoryInput{
+			First:   first,
+			Outputs: outputs,
+			After:   after,
+		},
+	}
+	var err error
+
+	var data HistoryResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by InputException.
+const InputException_Operation = `
+query InputException ($index: Int!) {
+	input(index: $index) {
+		exception
+	}
+}
+`
+
+// Get the exception payload of an input; only nonodo readers have this field.
+func InputException(
+	ctx context.Context,
+	client graphql.Client,
+	index int,
+) (*InputExceptionResponse, error) {
+	req := &graphql.Request{
+		OpName: "InputException",
+		Query:  InputException_Operation,
+		Variables: &__InputExceptionInput{
+			Index: index,
+		},
+	}
+	var err error
+
+	var data InputExceptionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by InputNotices.
+const InputNotices_Operation = `
+query InputNotices ($index: Int!, $first: Int!, $after: String!) {
+	input(index: $index) {
+		notices(first: $first, after: $after) {
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+			edges {
+				node {
+					payload
+				}
+			}
+		}
+	}
+}
+`
+
+// Get the next page of notices of an input.
+func InputNotices(
+	ctx context.Context,
+	client graphql.Client,
+	index int,
+	first int,
+	after string,
+) (*InputNoticesResponse, error) {
+	req := &graphql.Request{
+		OpName: "InputNotices",
+		Query:  InputNotices_Operation,
+		Variables: &__InputNoticesInput{
+			Index: index,
+			First: first,
+			After: after,
+		},
+	}
+	var err error
+
+	var data InputNoticesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by InputReports.
+const InputReports_Operation = `
+query InputReports ($index: Int!, $first: Int!, $after: String!) {
+	input(index: $index) {
+		reports(first: $first, after: $after) {
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+			edges {
+				node {
+					payload
+				}
+			}
+		}
+	}
+}
+`
+
+// Get the next page of reports of an input.
+func InputReports(
+	ctx context.Context,
+	client graphql.Client,
+	index int,
+	first int,
+	after string,
+) (*InputReportsResponse, error) {
+	req := &graphql.Request{
+		OpName: "InputReports",
+		Query:  InputReports_Operation,
+		Variables: &__InputReportsInput{
+			Index: index,
+			First: first,
+			After: after,
+		},
+	}
+	var err error
+
+	var data InputReportsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by InputStatus.
 const InputStatus_Operation = `
 query InputStatus ($index: Int!) {
@@ -339,6 +1024,57 @@ func InputStatus(
 	return &data, err
 }
 
+// The query or mutation executed by InputVouchers.
+const InputVouchers_Operation = `
+query InputVouchers ($index: Int!, $first: Int!, $after: String!) {
+	input(index: $index) {
+		vouchers(first: $first, after: $after) {
+			pageInfo {
+				endCursor
+				hasNextPage
+			}
+			edges {
+				node {
+					payload
+					destination
+				}
+			}
+		}
+	}
+}
+`
+
+// Get the next page of vouchers of an input.
+func InputVouchers(
+	ctx context.Context,
+	client graphql.Client,
+	index int,
+	first int,
+	after string,
+) (*InputVouchersResponse, error) {
+	req := &graphql.Request{
+		OpName: "InputVouchers",
+		Query:  InputVouchers_Operation,
+		Variables: &__InputVouchersInput{
+			Index: index,
+			First: first,
+			After: after,
+		},
+	}
+	var err error
+
+	var data InputVouchersResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 // The query or mutation executed by State.
 const State_Operation = `
 query State {
@@ -0,0 +1,247 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package rollup
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Default parameters of the faults.
+const (
+	DefaultFaultMaxLatency     = 1000
+	DefaultFaultNoRequestBurst = 5
+)
+
+// Probabilities of injecting each fault in a request to the rollup API.
+// The duplicate and no-request faults only apply to the finish requests.
+type Faults struct {
+	// Delay the request by a random duration up to MaxLatencyMs milliseconds.
+	Latency      float64 `json:"latency"`
+	MaxLatencyMs int64   `json:"maxLatencyMs"`
+
+	// Respond with an internal server error without handling the request.
+	Error float64 `json:"error"`
+
+	// Drop the connection without handling the request.
+	Drop float64 `json:"drop"`
+
+	// Respond with the previous finish response without handling the request.
+	Duplicate float64 `json:"duplicate"`
+
+	// Respond with no rollup request available to NoRequestBurst finish requests in a row.
+	NoRequest      float64 `json:"noRequest"`
+	NoRequestBurst int     `json:"noRequestBurst"`
+}
+
+// Parse the faults from a comma-separated list of key=value entries.
+// The keys are the same as the JSON fields, like latency=0.1,maxLatencyMs=500.
+func ParseFaults(spec string) (Faults, error) {
+	var faults Faults
+	for _, entry := range strings.Split(spec, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			return Faults{}, fmt.Errorf("rollup: invalid fault entry: %q", entry)
+		}
+		var err error
+		switch key {
+		case "latency":
+			faults.Latency, err = strconv.ParseFloat(value, 64)
+		case "maxLatencyMs":
+			faults.MaxLatencyMs, err = strconv.ParseInt(value, 10, 64)
+		case "error":
+			faults.Error, err = strconv.ParseFloat(value, 64)
+		case "drop":
+			faults.Drop, err = strconv.ParseFloat(value, 64)
+		case "duplicate":
+			faults.Duplicate, err = strconv.ParseFloat(value, 64)
+		case "noRequest":
+			faults.NoRequest, err = strconv.ParseFloat(value, 64)
+		case "noRequestBurst":
+			faults.NoRequestBurst, err = strconv.Atoi(value)
+		default:
+			return Faults{}, fmt.Errorf("rollup: invalid fault: %v", key)
+		}
+		if err != nil {
+			return Faults{}, fmt.Errorf("rollup: invalid value for %v: %w", key, err)
+		}
+	}
+	if err := faults.Validate(); err != nil {
+		return Faults{}, err
+	}
+	return faults, nil
+}
+
+// Check the probabilities and set the default parameters.
+func (f *Faults) Validate() error {
+	probabilities := map[string]float64{
+		"latency":   f.Latency,
+		"error":     f.Error,
+		"drop":      f.Drop,
+		"duplicate": f.Duplicate,
+		"noRequest": f.NoRequest,
+	}
+	for name, probability := range probabilities {
+		if probability < 0 || probability > 1 {
+			return fmt.Errorf("rollup: %v probability must be between 0 and 1", name)
+		}
+	}
+	if f.MaxLatencyMs < 0 || f.NoRequestBurst < 0 {
+		return fmt.Errorf("rollup: fault parameters cannot be negative")
+	}
+	if f.MaxLatencyMs == 0 {
+		f.MaxLatencyMs = DefaultFaultMaxLatency
+	}
+	if f.NoRequestBurst == 0 {
+		f.NoRequestBurst = DefaultFaultNoRequestBurst
+	}
+	return nil
+}
+
+// Middleware that injects faults in the rollup API.
+// The faults are injected before the API handles the request, so they don't change the model.
+type FaultInjector struct {
+	mutex  sync.Mutex
+	faults Faults
+	rand   *rand.Rand
+
+	// Number of finish requests that still receive no rollup request available.
+	burst int
+
+	// Body of the last successful finish response.
+	lastFinish []byte
+}
+
+func NewFaultInjector(faults Faults) *FaultInjector {
+	return &FaultInjector{
+		faults: faults,
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Get the current faults.
+func (f *FaultInjector) Faults() Faults {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.faults
+}
+
+// Set the faults, which apply to the next requests.
+func (f *FaultInjector) SetFaults(faults Faults) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.faults = faults
+	f.burst = 0
+}
+
+// Wrap the handler of a rollup API route.
+func (f *FaultInjector) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		path := c.Request().URL.Path
+		finish := strings.HasSuffix(path, "/finish")
+		fault, delay, lastFinish := f.roll(finish)
+		if fault != "" {
+			slog.Warn("rollup: injected fault", "fault", fault, "path", path, "delay", delay)
+		}
+		switch fault {
+		case "latency":
+			select {
+			case <-c.Request().Context().Done():
+				return c.String(http.StatusInternalServerError, c.Request().Context().Err().Error())
+			case <-time.After(delay):
+			}
+		case "error":
+			return c.String(http.StatusInternalServerError, "injected fault")
+		case "drop":
+			// the HTTP server closes the connection without sending a response
+			panic(http.ErrAbortHandler)
+		case "duplicate":
+			return c.JSONBlob(http.StatusOK, lastFinish)
+		case "noRequest":
+			return c.String(http.StatusAccepted, "no rollup request available")
+		}
+		if !finish {
+			return next(c)
+		}
+		recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = recorder
+		err := next(c)
+		if c.Response().Status == http.StatusOK {
+			f.mutex.Lock()
+			f.lastFinish = recorder.body.Bytes()
+			f.mutex.Unlock()
+		}
+		return err
+	}
+}
+
+// Choose the fault injected in the request, if any.
+// Return the fault name, the delay for the latency fault, and the response for the duplicate
+// fault.
+func (f *FaultInjector) roll(finish bool) (string, time.Duration, []byte) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if finish && f.burst > 0 {
+		f.burst--
+		return "noRequest", 0, nil
+	}
+	if f.rand.Float64() < f.faults.Drop {
+		return "drop", 0, nil
+	}
+	if f.rand.Float64() < f.faults.Error {
+		return "error", 0, nil
+	}
+	if finish && f.lastFinish != nil && f.rand.Float64() < f.faults.Duplicate {
+		return "duplicate", 0, f.lastFinish
+	}
+	if finish && f.rand.Float64() < f.faults.NoRequest {
+		f.burst = f.faults.NoRequestBurst - 1
+		return "noRequest", 0, nil
+	}
+	if f.rand.Float64() < f.faults.Latency {
+		delay := time.Duration(f.rand.Int63n(f.faults.MaxLatencyMs+1)) * time.Millisecond
+		return "latency", delay, nil
+	}
+	return "", 0, nil
+}
+
+// Register the admin endpoint that gets and sets the faults at runtime.
+func (f *FaultInjector) RegisterAdmin(e *echo.Echo) {
+	e.GET("/admin/faults", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, f.Faults())
+	})
+	e.PUT("/admin/faults", func(c echo.Context) error {
+		var faults Faults
+		if err := c.Bind(&faults); err != nil {
+			return err
+		}
+		if err := faults.Validate(); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		f.SetFaults(faults)
+		slog.Info("rollup: updated faults", "faults", fmt.Sprintf("%+v", faults))
+		return c.JSON(http.StatusOK, faults)
+	})
+}
+
+// Response writer that stores a copy of the body.
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package rollup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFaults(t *testing.T) {
+	faults, err := ParseFaults("latency=0.5,error=0.1,noRequest=1,noRequestBurst=3")
+	require.Nil(t, err)
+	assert.Equal(t, Faults{
+		Latency:        0.5,
+		MaxLatencyMs:   DefaultFaultMaxLatency,
+		Error:          0.1,
+		NoRequest:      1,
+		NoRequestBurst: 3,
+	}, faults)
+
+	for _, spec := range []string{"", "latency", "latency=2", "foo=0.1", "drop=-1"} {
+		_, err := ParseFaults(spec)
+		assert.NotNil(t, err, spec)
+	}
+}
+
+func TestFaultInjector(t *testing.T) {
+	m := model.NewNonodoModel()
+	e := echo.New()
+	faults := NewFaultInjector(Faults{})
+	RegisterWithOpts(e, m, Opts{Faults: faults})
+	server := httptest.NewServer(e)
+	defer server.Close()
+	client, err := NewClientWithResponses(server.URL + "/rollup")
+	require.Nil(t, err)
+	ctx := context.Background()
+	finish := func() (int, string) {
+		resp, err := client.FinishWithResponse(ctx, Finish{Status: Accept})
+		if err != nil {
+			return 0, ""
+		}
+		return resp.StatusCode(), string(resp.Body)
+	}
+
+	m.AddAdvanceInput(common.Address{}, []byte("first"), 0, time.Now())
+	status, first := finish()
+	assert.Equal(t, http.StatusOK, status)
+
+	faults.SetFaults(Faults{Duplicate: 1})
+	status, body := finish()
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, first, body)
+
+	faults.SetFaults(Faults{Error: 1})
+	status, _ = finish()
+	assert.Equal(t, http.StatusInternalServerError, status)
+
+	faults.SetFaults(Faults{Drop: 1})
+	status, _ = finish()
+	assert.Equal(t, 0, status)
+
+	faults.SetFaults(Faults{NoRequest: 1, NoRequestBurst: 2})
+	for i := 0; i < 2; i++ {
+		status, _ = finish()
+		assert.Equal(t, http.StatusAccepted, status)
+	}
+	faults.SetFaults(Faults{})
+	m.AddAdvanceInput(common.Address{}, []byte("second"), 0, time.Now())
+	status, body = finish()
+	assert.Equal(t, http.StatusOK, status)
+	assert.True(t, strings.Contains(body, "0x7365636f6e64"), body)
+
+	// the model only processed the requests that passed through the injector
+	input, ok := m.GetAdvanceInput(0)
+	require.True(t, ok)
+	assert.Equal(t, model.CompletionStatusAccepted, input.Status)
+}
+
+func TestFaultsAdmin(t *testing.T) {
+	e := echo.New()
+	faults := NewFaultInjector(Faults{})
+	RegisterWithOpts(e, model.NewNonodoModel(), Opts{Faults: faults})
+
+	req := httptest.NewRequest(http.MethodPut, "/admin/faults",
+		strings.NewReader(`{"latency":0.2,"maxLatencyMs":10}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 0.2, faults.Faults().Latency)
+	assert.Equal(t, DefaultFaultNoRequestBurst, faults.Faults().NoRequestBurst)
+
+	req = httptest.NewRequest(http.MethodPut, "/admin/faults", strings.NewReader(`{"error":3}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/admin/faults", nil)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"latency":0.2`)
+}
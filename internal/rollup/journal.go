@@ -0,0 +1,337 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package rollup
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/carlmjohnson/versioninfo"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/labstack/echo/v4"
+)
+
+// Default number of exchanges kept in the journal.
+const DefaultJournalSize = 1000
+
+// Maximum number of bytes of the request and response bodies kept in the journal.
+const MaxJournalBytes = 32 << 20
+
+// Kinds of inputs in the journal entries.
+const (
+	JournalInputAdvance = "advance"
+	JournalInputInspect = "inspect"
+)
+
+// Exchange between the application and the rollup API.
+type JournalEntry struct {
+	// Sequence number of the exchange, starting from zero.
+	Seq int `json:"seq"`
+
+	// Input being processed when the request arrived; the kind is empty if the model was idle.
+	InputKind  string `json:"inputKind,omitempty"`
+	InputIndex int    `json:"inputIndex"`
+
+	// Time when the request arrived and time to respond it.
+	StartedAt time.Time     `json:"startedAt"`
+	Duration  time.Duration `json:"durationNs"`
+
+	Method string `json:"method"`
+	URL    string `json:"url"`
+
+	// Status of the response; zero if the connection was dropped.
+	Status int `json:"status"`
+
+	RequestType  string `json:"requestType"`
+	RequestBody  string `json:"requestBody"`
+	ResponseType string `json:"responseType"`
+	ResponseBody string `json:"responseBody"`
+}
+
+// Bounded journal of the exchanges between the application and the rollup API.
+// When the journal has too many entries or bytes, it drops the oldest entries.
+type Journal struct {
+	model    *model.NonodoModel
+	size     int
+	maxBytes int
+
+	mutex   sync.Mutex
+	entries []JournalEntry
+	bytes   int
+	next    int
+}
+
+func NewJournal(model *model.NonodoModel, size int) *Journal {
+	return &Journal{
+		model:    model,
+		size:     size,
+		maxBytes: MaxJournalBytes,
+	}
+}
+
+// Get the entries of the input in order; a negative index returns all entries.
+func (j *Journal) Entries(kind string, index int) []JournalEntry {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	var entries []JournalEntry
+	for _, entry := range j.entries {
+		if (kind == "" || entry.InputKind == kind) && (index < 0 || entry.InputIndex == index) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Wrap the handler of a rollup API route.
+func (j *Journal) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		entry := JournalEntry{
+			InputIndex:  -1,
+			StartedAt:   time.Now(),
+			Method:      c.Request().Method,
+			URL:         c.Scheme() + "://" + c.Request().Host + c.Request().URL.String(),
+			RequestType: c.Request().Header.Get(echo.HeaderContentType),
+		}
+		switch input := j.model.GetCurrentInput().(type) {
+		case model.AdvanceInput:
+			entry.InputKind = JournalInputAdvance
+			entry.InputIndex = input.Index
+		case model.InspectInput:
+			entry.InputKind = JournalInputInspect
+			entry.InputIndex = input.Index
+		}
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return err
+		}
+		c.Request().Body = io.NopCloser(bytes.NewReader(body))
+		entry.RequestBody = string(body)
+		recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = recorder
+
+		defer func() {
+			// the fault injector drops the connection by panicking
+			recovered := recover()
+			entry.Duration = time.Since(entry.StartedAt)
+			if recovered == nil {
+				entry.Status = c.Response().Status
+				entry.ResponseType = c.Response().Header().Get(echo.HeaderContentType)
+				entry.ResponseBody = recorder.body.String()
+			}
+			j.add(entry)
+			if recovered != nil {
+				panic(recovered)
+			}
+		}()
+
+		// write the error response now, so the journal records it
+		if err := next(c); err != nil {
+			c.Error(err)
+		}
+		return nil
+	}
+}
+
+// Add the entry, dropping the oldest ones while the journal is full.
+// The journal keeps the new entry even if its bodies alone exceed the maximum bytes.
+func (j *Journal) add(entry JournalEntry) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	entry.Seq = j.next
+	j.next++
+	j.entries = append(j.entries, entry)
+	j.bytes += entry.bodySize()
+	for len(j.entries) > 1 && (len(j.entries) > j.size || j.bytes > j.maxBytes) {
+		j.bytes -= j.entries[0].bodySize()
+		j.entries = j.entries[1:]
+	}
+}
+
+// Get the number of bytes of the request and response bodies.
+func (e JournalEntry) bodySize() int {
+	return len(e.RequestBody) + len(e.ResponseBody)
+}
+
+// Register the admin endpoint that exports the journal.
+// The query parameters filter the input kind and index, and set the format: ndjson or har.
+func (j *Journal) RegisterAdmin(e *echo.Echo) {
+	e.GET("/admin/journal", func(c echo.Context) error {
+		kind := c.QueryParam("kind")
+		switch kind {
+		case "", JournalInputAdvance, JournalInputInspect:
+		default:
+			return c.String(http.StatusBadRequest, "invalid kind")
+		}
+		index := -1
+		if value := c.QueryParam("input"); value != "" {
+			var err error
+			index, err = strconv.Atoi(value)
+			if err != nil || index < 0 {
+				return c.String(http.StatusBadRequest, "invalid input index")
+			}
+		}
+		entries := j.Entries(kind, index)
+		switch c.QueryParam("format") {
+		case "", "ndjson":
+			c.Response().Header().Set(echo.HeaderContentType, "application/x-ndjson")
+			c.Response().WriteHeader(http.StatusOK)
+			encoder := json.NewEncoder(c.Response())
+			for _, entry := range entries {
+				if err := encoder.Encode(entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		case "har":
+			return c.JSON(http.StatusOK, convertHAR(entries))
+		default:
+			return c.String(http.StatusBadRequest, "invalid format")
+		}
+	})
+}
+
+//
+// HTTP Archive
+//
+
+// HTTP Archive 1.2 with the journal entries.
+// See http://www.softwareishard.com/blog/har-12-spec/.
+type harFile struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	InputKind       string      `json:"_inputKind,omitempty"`
+	InputIndex      int         `json:"_inputIndex"`
+}
+
+type harRequest struct {
+	Method      string      `json:"method"`
+	URL         string      `json:"url"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []struct{}  `json:"cookies"`
+	Headers     []harHeader `json:"headers"`
+	QueryString []struct{}  `json:"queryString"`
+	PostData    harPostData `json:"postData"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int         `json:"status"`
+	StatusText  string      `json:"statusText"`
+	HTTPVersion string      `json:"httpVersion"`
+	Cookies     []struct{}  `json:"cookies"`
+	Headers     []harHeader `json:"headers"`
+	Content     harContent  `json:"content"`
+	RedirectURL string      `json:"redirectURL"`
+	HeadersSize int         `json:"headersSize"`
+	BodySize    int         `json:"bodySize"`
+}
+
+type harHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// Convert the journal entries to an HTTP Archive.
+func convertHAR(entries []JournalEntry) harFile {
+	const httpVersion = "HTTP/1.1"
+	const unknownSize = -1
+	har := harFile{
+		Log: harLog{
+			Version: "1.2",
+			Creator: harCreator{Name: "nonodo", Version: versioninfo.Short()},
+			Entries: make([]harEntry, len(entries)),
+		},
+	}
+	for i, entry := range entries {
+		milliseconds := float64(entry.Duration) / float64(time.Millisecond)
+		har.Log.Entries[i] = harEntry{
+			StartedDateTime: entry.StartedAt.Format(time.RFC3339Nano),
+			Time:            milliseconds,
+			Request: harRequest{
+				Method:      entry.Method,
+				URL:         entry.URL,
+				HTTPVersion: httpVersion,
+				Cookies:     []struct{}{},
+				Headers: []harHeader{
+					{Name: echo.HeaderContentType, Value: entry.RequestType},
+				},
+				QueryString: []struct{}{},
+				PostData: harPostData{
+					MimeType: entry.RequestType,
+					Text:     entry.RequestBody,
+				},
+				HeadersSize: unknownSize,
+				BodySize:    len(entry.RequestBody),
+			},
+			Response: harResponse{
+				Status:      entry.Status,
+				StatusText:  http.StatusText(entry.Status),
+				HTTPVersion: httpVersion,
+				Cookies:     []struct{}{},
+				Headers: []harHeader{
+					{Name: echo.HeaderContentType, Value: entry.ResponseType},
+				},
+				Content: harContent{
+					Size:     len(entry.ResponseBody),
+					MimeType: entry.ResponseType,
+					Text:     entry.ResponseBody,
+				},
+				HeadersSize: unknownSize,
+				BodySize:    len(entry.ResponseBody),
+			},
+			Timings: harTimings{
+				Send:    0,
+				Wait:    milliseconds,
+				Receive: 0,
+			},
+			InputKind:  entry.InputKind,
+			InputIndex: entry.InputIndex,
+		}
+	}
+	return har
+}
@@ -0,0 +1,143 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package rollup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal(t *testing.T) {
+	m := model.NewNonodoModel()
+	e := echo.New()
+	journal := NewJournal(m, DefaultJournalSize)
+	faults := NewFaultInjector(Faults{})
+	RegisterWithOpts(e, m, Opts{Faults: faults, Journal: journal})
+	server := httptest.NewServer(e)
+	defer server.Close()
+	client, err := NewClientWithResponses(server.URL + "/rollup")
+	require.Nil(t, err)
+	ctx := context.Background()
+
+	m.AddAdvanceInput(common.Address{}, []byte("first"), 0, time.Now())
+	m.AddAdvanceInput(common.Address{}, []byte("second"), 0, time.Now())
+	_, err = client.Finish(ctx, Finish{Status: Accept})
+	require.Nil(t, err)
+	_, err = client.AddNotice(ctx, Notice{Payload: "0x00"})
+	require.Nil(t, err)
+	_, err = client.AddNotice(ctx, Notice{Payload: "invalid"})
+	require.Nil(t, err)
+	faults.SetFaults(Faults{Drop: 1})
+	_, err = client.AddReport(ctx, Report{Payload: "0x00"})
+	require.NotNil(t, err)
+	faults.SetFaults(Faults{})
+	_, err = client.Finish(ctx, Finish{Status: Accept})
+	require.Nil(t, err)
+	_, err = client.AddReport(ctx, Report{Payload: "0x01"})
+	require.Nil(t, err)
+
+	entries := journal.Entries("", -1)
+	require.Len(t, entries, 6)
+	for i, entry := range entries {
+		assert.Equal(t, i, entry.Seq)
+		assert.Equal(t, http.MethodPost, entry.Method)
+	}
+	assert.Equal(t, "", entries[0].InputKind)
+	assert.Equal(t, -1, entries[0].InputIndex)
+	assert.Contains(t, entries[0].ResponseBody, "advance_state")
+	assert.Equal(t, `{"payload":"0x00"}`, entries[1].RequestBody)
+	assert.Equal(t, http.StatusOK, entries[1].Status)
+	assert.Equal(t, http.StatusBadRequest, entries[2].Status)
+	assert.Equal(t, 0, entries[3].Status)
+	assert.Equal(t, JournalInputAdvance, entries[5].InputKind)
+	assert.Equal(t, 1, entries[5].InputIndex)
+
+	// filter by input
+	entries = journal.Entries(JournalInputAdvance, 0)
+	require.Len(t, entries, 4)
+	assert.Equal(t, 1, entries[0].Seq)
+	assert.True(t, strings.HasSuffix(entries[3].URL, "/rollup/finish"))
+
+	// export as ndjson
+	resp, err := http.Get(server.URL + "/admin/journal?kind=advance&input=1")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	var entry JournalEntry
+	decoder := json.NewDecoder(resp.Body)
+	require.Nil(t, decoder.Decode(&entry))
+	assert.Equal(t, 5, entry.Seq)
+	assert.False(t, decoder.More())
+
+	// export as har
+	resp, err = http.Get(server.URL + "/admin/journal?format=har")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	var har harFile
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&har))
+	assert.Equal(t, "1.2", har.Log.Version)
+	require.Len(t, har.Log.Entries, 6)
+	assert.Equal(t, `{"payload":"0x00"}`, har.Log.Entries[1].Request.PostData.Text)
+	assert.Equal(t, http.StatusBadRequest, har.Log.Entries[2].Response.Status)
+
+	// invalid query
+	resp, err = http.Get(server.URL + "/admin/journal?format=xml")
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestJournalIsBounded(t *testing.T) {
+	const size = 3
+	m := model.NewNonodoModel()
+	e := echo.New()
+	journal := NewJournal(m, size)
+	RegisterWithOpts(e, m, Opts{Journal: journal})
+	for i := 0; i < size+2; i++ {
+		req := httptest.NewRequest(http.MethodPost, "/rollup/report",
+			strings.NewReader(`{"payload":"0x00"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	entries := journal.Entries("", -1)
+	require.Len(t, entries, size)
+	assert.Equal(t, 2, entries[0].Seq)
+	assert.Equal(t, size+1, entries[size-1].Seq)
+}
+
+func TestJournalIsBoundedByBytes(t *testing.T) {
+	m := model.NewNonodoModel()
+	e := echo.New()
+	journal := NewJournal(m, DefaultJournalSize)
+	RegisterWithOpts(e, m, Opts{Journal: journal})
+	report := func() {
+		req := httptest.NewRequest(http.MethodPost, "/rollup/report",
+			strings.NewReader(`{"payload":"0x00"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+	report()
+	entries := journal.Entries("", -1)
+	require.Len(t, entries, 1)
+
+	// the journal fits the bodies of two entries
+	journal.maxBytes = 2 * entries[0].bodySize()
+	for i := 0; i < 3; i++ {
+		report()
+	}
+	entries = journal.Entries("", -1)
+	require.Len(t, entries, 2)
+	assert.Equal(t, 2, entries[0].Seq)
+	assert.Equal(t, 3, entries[1].Seq)
+}
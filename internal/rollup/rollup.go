@@ -22,24 +22,66 @@ const FinishPollInterval = time.Millisecond * 100
 
 // Register the rollup API to echo
 func Register(e *echo.Echo, model *model.NonodoModel) {
-	rollupAPI := &rollupAPI{model}
-	RegisterHandlersWithBaseURL(e, rollupAPI, "rollup")
+	RegisterWithBaseURL(e, model, "rollup")
+}
+
+// Register the rollup API to echo using the given base URL.
+// This allows serving multiple rollup APIs, each one with its own model.
+func RegisterWithBaseURL(e *echo.Echo, model *model.NonodoModel, baseURL string) {
+	rollupAPI := &rollupAPI{model: model}
+	RegisterHandlersWithBaseURL(e, rollupAPI, baseURL)
+}
+
+// Options of the main rollup API.
+type Opts struct {
+	// If set, inject faults in front of the API and register the admin endpoint that changes
+	// the faults at runtime.
+	Faults *FaultInjector
+
+	// If set, check the requests against the spec and the behavior of the real server,
+	// recording the conformance violations in the model.
+	Strict bool
+
+	// If set, record the exchanges in the journal and register the admin endpoint that exports
+	// it.
+	Journal *Journal
+}
+
+// Register the rollup API to echo with the given options.
+func RegisterWithOpts(e *echo.Echo, model *model.NonodoModel, opts Opts) {
+	rollupAPI := &rollupAPI{model: model, strict: opts.Strict}
+	var middlewares []echo.MiddlewareFunc
+	if opts.Journal != nil {
+		// the journal comes first, so it records the injected faults
+		middlewares = append(middlewares, opts.Journal.Middleware)
+		opts.Journal.RegisterAdmin(e)
+	}
+	if opts.Faults != nil {
+		middlewares = append(middlewares, opts.Faults.Middleware)
+		opts.Faults.RegisterAdmin(e)
+	}
+	group := e.Group("/rollup", middlewares...)
+	RegisterHandlers(group, rollupAPI)
 }
 
 // Shared struct for request handlers.
 type rollupAPI struct {
 	model *model.NonodoModel
+
+	// If set, record the conformance violations in the model.
+	strict bool
 }
 
 // Handle requests to /finish.
 func (r *rollupAPI) Finish(c echo.Context) error {
 	if !checkContentType(c) {
-		return c.String(http.StatusUnsupportedMediaType, "invalid content type")
+		return r.reject(c, http.StatusUnsupportedMediaType, "invalid content type")
 	}
 
 	// parse body
 	var request FinishJSONRequestBody
 	if err := c.Bind(&request); err != nil {
+		r.violation(c, "invalid request body")
 		return err
 	}
 
@@ -51,7 +93,7 @@ func (r *rollupAPI) Finish(c echo.Context) error {
 	case Reject:
 		accepted = false
 	default:
-		return c.String(http.StatusBadRequest, "invalid value for status")
+		return r.reject(c, http.StatusBadRequest, "invalid value for status")
 	}
 
 	// talk to model
@@ -74,32 +116,37 @@ func (r *rollupAPI) Finish(c echo.Context) error {
 // Handle requests to /voucher.
 func (r *rollupAPI) AddVoucher(c echo.Context) error {
 	if !checkContentType(c) {
-		return c.String(http.StatusUnsupportedMediaType, "invalid content type")
+		return r.reject(c, http.StatusUnsupportedMediaType, "invalid content type")
 	}
 
 	// parse body
 	var request AddVoucherJSONRequestBody
 	if err := c.Bind(&request); err != nil {
+		r.violation(c, "invalid request body")
 		return err
 	}
 
 	// validate fields
 	destination, err := hexutil.Decode(request.Destination)
 	if err != nil {
-		return c.String(http.StatusBadRequest, "invalid hex payload")
+		return r.reject(c, http.StatusBadRequest, "invalid hex payload")
 	}
 	if len(destination) != common.AddressLength {
-		return c.String(http.StatusBadRequest, "invalid address length")
+		return r.reject(c, http.StatusBadRequest, "invalid address length")
 	}
+	r.checkAddress(c, request.Destination)
 	payload, err := hexutil.Decode(request.Payload)
 	if err != nil {
-		return c.String(http.StatusBadRequest, "invalid hex payload")
+		return r.reject(c, http.StatusBadRequest, "invalid hex payload")
 	}
+	r.checkPayload(c, request.Payload, payload)
+
+	r.checkVoucherPayload(c, payload)
 
 	// talk to model
 	index, err := r.model.AddVoucher(common.Address(destination), payload)
 	if err != nil {
-		return c.String(http.StatusForbidden, err.Error())
+		return r.reject(c, http.StatusForbidden, err.Error())
 	}
 	resp := IndexResponse{
 		Index: uint64(index),
@@ -110,25 +157,27 @@ func (r *rollupAPI) AddVoucher(c echo.Context) error {
 // Handle requests to /notice.
 func (r *rollupAPI) AddNotice(c echo.Context) error {
 	if !checkContentType(c) {
-		return c.String(http.StatusUnsupportedMediaType, "invalid content type")
+		return r.reject(c, http.StatusUnsupportedMediaType, "invalid content type")
 	}
 
 	// parse body
 	var request AddNoticeJSONRequestBody
 	if err := c.Bind(&request); err != nil {
+		r.violation(c, "invalid request body")
 		return err
 	}
 
 	// validate fields
 	payload, err := hexutil.Decode(request.Payload)
 	if err != nil {
-		return c.String(http.StatusBadRequest, "invalid hex payload")
+		return r.reject(c, http.StatusBadRequest, "invalid hex payload")
 	}
+	r.checkPayload(c, request.Payload, payload)
 
 	// talk to model
 	index, err := r.model.AddNotice(payload)
 	if err != nil {
-		return c.String(http.StatusForbidden, err.Error())
+		return r.reject(c, http.StatusForbidden, err.Error())
 	}
 	resp := IndexResponse{
 		Index: uint64(index),
@@ -139,25 +188,27 @@ func (r *rollupAPI) AddNotice(c echo.Context) error {
 // Handle requests to /report.
 func (r *rollupAPI) AddReport(c echo.Context) error {
 	if !checkContentType(c) {
-		return c.String(http.StatusUnsupportedMediaType, "invalid content type")
+		return r.reject(c, http.StatusUnsupportedMediaType, "invalid content type")
 	}
 
 	// parse body
 	var request AddReportJSONRequestBody
 	if err := c.Bind(&request); err != nil {
+		r.violation(c, "invalid request body")
 		return err
 	}
 
 	// validate fields
 	payload, err := hexutil.Decode(request.Payload)
 	if err != nil {
-		return c.String(http.StatusBadRequest, "invalid hex payload")
+		return r.reject(c, http.StatusBadRequest, "invalid hex payload")
 	}
+	r.checkPayload(c, request.Payload, payload)
 
 	// talk to model
 	err = r.model.AddReport(payload)
 	if err != nil {
-		return c.String(http.StatusForbidden, err.Error())
+		return r.reject(c, http.StatusForbidden, err.Error())
 	}
 	return c.NoContent(http.StatusOK)
 }
@@ -165,25 +216,31 @@ func (r *rollupAPI) AddReport(c echo.Context) error {
 // Handle requests to /exception.
 func (r *rollupAPI) RegisterException(c echo.Context) error {
 	if !checkContentType(c) {
-		return c.String(http.StatusUnsupportedMediaType, "invalid content type")
+		return r.reject(c, http.StatusUnsupportedMediaType, "invalid content type")
 	}
 
 	// parse body
 	var request RegisterExceptionJSONRequestBody
 	if err := c.Bind(&request); err != nil {
+		r.violation(c, "invalid request body")
 		return err
 	}
 
 	// validate fields
 	payload, err := hexutil.Decode(request.Payload)
 	if err != nil {
-		return c.String(http.StatusBadRequest, "invalid hex payload")
+		return r.reject(c, http.StatusBadRequest, "invalid hex payload")
+	}
+	r.checkPayload(c, request.Payload, payload)
+
+	if _, ok := r.model.GetCurrentInput().(model.InspectInput); ok {
+		r.violation(c, "exception registered during inspect")
 	}
 
 	// talk to model
 	err = r.model.RegisterException(payload)
 	if err != nil {
-		return c.String(http.StatusForbidden, err.Error())
+		return r.reject(c, http.StatusForbidden, err.Error())
 	}
 	return c.NoContent(http.StatusOK)
 }
@@ -0,0 +1,79 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package rollup
+
+import (
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/labstack/echo/v4"
+)
+
+// Size of the rollup buffer of the Cartesi machine, which limits the size of each payload.
+const StrictPayloadLimit = 2 * 1024 * 1024
+
+// Size of the function selector at the start of the voucher payload.
+const voucherSelectorSize = 4
+
+// Respond with an error, recording the conformance violation in strict mode.
+func (r *rollupAPI) reject(c echo.Context, status int, message string) error {
+	r.violation(c, message)
+	return c.String(status, message)
+}
+
+// Record the conformance violation in the current input if the API is in strict mode.
+func (r *rollupAPI) violation(c echo.Context, format string, args ...any) {
+	if !r.strict {
+		return
+	}
+	route := path.Base(c.Request().URL.Path)
+	violation := fmt.Sprintf("%v: %v", route, fmt.Sprintf(format, args...))
+	slog.Warn("rollup: conformance violation", "violation", violation)
+	r.model.AddViolation(violation)
+}
+
+// Check the payload in strict mode.
+func (r *rollupAPI) checkPayload(c echo.Context, hex string, payload []byte) {
+	if !r.strict {
+		return
+	}
+	if hex != strings.ToLower(hex) {
+		r.violation(c, "payload has uppercase hex digits")
+	}
+	if len(payload) == 0 {
+		r.violation(c, "payload is empty")
+	}
+	if len(payload) > StrictPayloadLimit {
+		r.violation(c, "payload has %v bytes, exceeding the machine limit of %v bytes",
+			len(payload), StrictPayloadLimit)
+	}
+}
+
+// Check the voucher destination in strict mode.
+// The address should be in lowercase or have a valid checksum.
+func (r *rollupAPI) checkAddress(c echo.Context, hex string) {
+	if !r.strict {
+		return
+	}
+	address := common.HexToAddress(hex)
+	if hex != strings.ToLower(hex) && hex != address.Hex() {
+		r.violation(c, "destination %v has an invalid checksum", hex)
+	}
+	if address == (common.Address{}) {
+		r.violation(c, "destination is the zero address")
+	}
+}
+
+// Check the voucher payload in strict mode.
+func (r *rollupAPI) checkVoucherPayload(c echo.Context, payload []byte) {
+	if !r.strict {
+		return
+	}
+	if len(payload) > 0 && len(payload) < voucherSelectorSize {
+		r.violation(c, "payload is too short to contain a function selector")
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package rollup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrictViolations(t *testing.T) {
+	m := model.NewNonodoModel()
+	e := echo.New()
+	RegisterWithOpts(e, m, Opts{Strict: true})
+	server := httptest.NewServer(e)
+	defer server.Close()
+	client, err := NewClientWithResponses(server.URL + "/rollup")
+	require.Nil(t, err)
+	ctx := context.Background()
+
+	m.AddAdvanceInput(common.Address{}, []byte("advance"), 0, time.Now())
+	m.AddInspectInput([]byte("inspect"))
+
+	// idle
+	resp, err := client.AddNotice(ctx, Notice{Payload: "0x00"})
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// inspect
+	_, err = client.Finish(ctx, Finish{Status: Accept})
+	require.Nil(t, err)
+	resp, err = client.RegisterException(ctx, Exception{Payload: "0x00"})
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// advance
+	_, err = client.Finish(ctx, Finish{Status: Accept})
+	require.Nil(t, err)
+	resp, err = client.AddVoucher(ctx, Voucher{
+		Destination: "0x000000000000000000000000000000000000ABcd",
+		Payload:     "0xAB",
+	})
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, err = client.AddVoucher(ctx, Voucher{
+		Destination: "0x0000000000000000000000000000000000000000",
+		Payload:     "0xdeadbeef",
+	})
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, err = client.AddReport(ctx, Report{Payload: "0x"})
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp, err = client.AddNotice(ctx, Notice{Payload: "0x0"})
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	m.AddInspectInput([]byte("next")) // so finish returns right away
+	_, err = client.Finish(ctx, Finish{Status: Accept})
+	require.Nil(t, err)
+
+	violations := m.GetViolations()
+	assert.Equal(t, []string{"notice: cannot add notice in current state"}, violations.Idle)
+	assert.Equal(t, map[int][]string{
+		0: {"exception: exception registered during inspect"},
+	}, violations.Inspects)
+	assert.Equal(t, map[int][]string{
+		0: {
+			"voucher: destination 0x000000000000000000000000000000000000ABcd has an invalid " +
+				"checksum",
+			"voucher: payload has uppercase hex digits",
+			"voucher: payload is too short to contain a function selector",
+			"voucher: destination is the zero address",
+			"report: payload is empty",
+			"notice: invalid hex payload",
+		},
+	}, violations.Advances)
+}
+
+func TestStrictDisabled(t *testing.T) {
+	m := model.NewNonodoModel()
+	e := echo.New()
+	Register(e, m)
+	server := httptest.NewServer(e)
+	defer server.Close()
+	client, err := NewClientWithResponses(server.URL + "/rollup")
+	require.Nil(t, err)
+
+	resp, err := client.AddNotice(context.Background(), Notice{Payload: "0x00"})
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Empty(t, m.GetViolations().Idle)
+}
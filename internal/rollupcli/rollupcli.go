@@ -0,0 +1,150 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+// This pkg emulates the rollup command of the Cartesi machine tools on top of the rollup API.
+// Each command reads a JSON object from stdin and writes the JSON response to stdout, so shell
+// applications written for the machine can run in the host unmodified.
+package rollupcli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/gligneul/nonodo/internal/rollup"
+)
+
+// Environment variable with the URL of the rollup API.
+const EndpointEnv = "ROLLUP_HTTP_SERVER_URL"
+
+// URL of the rollup API when the environment variable is not set.
+const DefaultEndpoint = "http://127.0.0.1:8080/rollup"
+
+// Commands of the rollup tool.
+var Commands = []string{"voucher", "notice", "report", "exception", "finish", "accept", "reject"}
+
+// Get the URL of the rollup API from the environment.
+func Endpoint() string {
+	if endpoint, ok := os.LookupEnv(EndpointEnv); ok && endpoint != "" {
+		return endpoint
+	}
+	return DefaultEndpoint
+}
+
+// Run the rollup command using the rollup API at the endpoint.
+func Run(
+	ctx context.Context,
+	endpoint string,
+	command string,
+	stdin io.Reader,
+	stdout io.Writer,
+) error {
+	client, err := rollup.NewClientWithResponses(endpoint)
+	if err != nil {
+		return fmt.Errorf("rollup: %w", err)
+	}
+	switch command {
+	case "voucher":
+		var voucher rollup.Voucher
+		if err := decode(stdin, &voucher); err != nil {
+			return err
+		}
+		resp, err := client.AddVoucherWithResponse(ctx, voucher)
+		if err != nil {
+			return fmt.Errorf("rollup: %w", err)
+		}
+		return respond(stdout, resp.HTTPResponse, resp.Body, true)
+	case "notice":
+		var notice rollup.Notice
+		if err := decode(stdin, &notice); err != nil {
+			return err
+		}
+		resp, err := client.AddNoticeWithResponse(ctx, notice)
+		if err != nil {
+			return fmt.Errorf("rollup: %w", err)
+		}
+		return respond(stdout, resp.HTTPResponse, resp.Body, true)
+	case "report":
+		var report rollup.Report
+		if err := decode(stdin, &report); err != nil {
+			return err
+		}
+		resp, err := client.AddReportWithResponse(ctx, report)
+		if err != nil {
+			return fmt.Errorf("rollup: %w", err)
+		}
+		return respond(stdout, resp.HTTPResponse, resp.Body, false)
+	case "exception":
+		var exception rollup.Exception
+		if err := decode(stdin, &exception); err != nil {
+			return err
+		}
+		resp, err := client.RegisterExceptionWithResponse(ctx, exception)
+		if err != nil {
+			return fmt.Errorf("rollup: %w", err)
+		}
+		return respond(stdout, resp.HTTPResponse, resp.Body, false)
+	case "finish":
+		var finish rollup.Finish
+		if err := decode(stdin, &finish); err != nil {
+			return err
+		}
+		return next(ctx, client, finish, stdout)
+	case "accept":
+		return next(ctx, client, rollup.Finish{Status: rollup.Accept}, stdout)
+	case "reject":
+		return next(ctx, client, rollup.Finish{Status: rollup.Reject}, stdout)
+	default:
+		return fmt.Errorf("rollup: unknown command: %v", command)
+	}
+}
+
+// Finish the current request and write the next one, waiting until there is one.
+func next(
+	ctx context.Context,
+	client *rollup.ClientWithResponses,
+	finish rollup.Finish,
+	stdout io.Writer,
+) error {
+	for {
+		resp, err := client.FinishWithResponse(ctx, finish)
+		if err != nil {
+			return fmt.Errorf("rollup: %w", err)
+		}
+		if resp.StatusCode() == http.StatusAccepted {
+			continue
+		}
+		return respond(stdout, resp.HTTPResponse, resp.Body, true)
+	}
+}
+
+// Decode the JSON object from stdin.
+func decode(stdin io.Reader, value any) error {
+	decoder := json.NewDecoder(stdin)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(value); err != nil {
+		return fmt.Errorf("rollup: invalid request: %w", err)
+	}
+	return nil
+}
+
+// Check the response status and write the response body to stdout if needed.
+func respond(stdout io.Writer, resp *http.Response, body []byte, output bool) error {
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("rollup: request failed: status=%v body=`%v`",
+			resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if !output {
+		return nil
+	}
+	body = append(bytes.TrimSpace(body), '\n')
+	if _, err := stdout.Write(body); err != nil {
+		return fmt.Errorf("rollup: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package rollupcli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/rollup"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupCommands(t *testing.T) {
+	m := model.NewNonodoModel()
+	e := echo.New()
+	rollup.Register(e, m)
+	server := httptest.NewServer(e)
+	defer server.Close()
+	endpoint := server.URL + "/rollup"
+	ctx := context.Background()
+	run := func(command string, stdin string) (string, error) {
+		var stdout bytes.Buffer
+		err := Run(ctx, endpoint, command, strings.NewReader(stdin), &stdout)
+		return stdout.String(), err
+	}
+
+	sender := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	m.AddAdvanceInput(sender, []byte("first"), 1, time.Unix(2, 0))
+	m.AddAdvanceInput(sender, []byte("second"), 1, time.Unix(2, 0))
+
+	stdout, err := run("accept", "")
+	require.Nil(t, err)
+	var request struct {
+		RequestType string `json:"request_type"`
+		Data        struct {
+			Metadata struct {
+				MsgSender  string `json:"msg_sender"`
+				InputIndex int    `json:"input_index"`
+			} `json:"metadata"`
+			Payload string `json:"payload"`
+		} `json:"data"`
+	}
+	require.Nil(t, json.Unmarshal([]byte(stdout), &request))
+	assert.Equal(t, "advance_state", request.RequestType)
+	assert.Equal(t, 0, request.Data.Metadata.InputIndex)
+	assert.Equal(t, "0x6669727374", request.Data.Payload)
+
+	stdout, err = run("voucher", `{"destination": "`+sender.Hex()+`", "payload": "0xdeadbeef"}`)
+	require.Nil(t, err)
+	assert.Equal(t, "{\"index\":0}\n", stdout)
+	stdout, err = run("notice", `{"payload": "0x01"}`)
+	require.Nil(t, err)
+	assert.Equal(t, "{\"index\":0}\n", stdout)
+	stdout, err = run("notice", `{"payload": "0x02"}`)
+	require.Nil(t, err)
+	assert.Equal(t, "{\"index\":1}\n", stdout)
+	stdout, err = run("report", `{"payload": "0x03"}`)
+	require.Nil(t, err)
+	assert.Empty(t, stdout)
+
+	stdout, err = run("finish", `{"status": "accept"}`)
+	require.Nil(t, err)
+	require.Nil(t, json.Unmarshal([]byte(stdout), &request))
+	assert.Equal(t, 1, request.Data.Metadata.InputIndex)
+	_, err = run("exception", `{"payload": "0x04"}`)
+	require.Nil(t, err)
+
+	first, _ := m.GetAdvanceInput(0)
+	assert.Equal(t, model.CompletionStatusAccepted, first.Status)
+	assert.Len(t, first.Vouchers, 1)
+	assert.Len(t, first.Notices, 2)
+	assert.Len(t, first.Reports, 1)
+	second, _ := m.GetAdvanceInput(1)
+	assert.Equal(t, model.CompletionStatusException, second.Status)
+	assert.Equal(t, []byte{4}, second.Exception)
+}
+
+func TestRollupCommandErrors(t *testing.T) {
+	m := model.NewNonodoModel()
+	e := echo.New()
+	rollup.Register(e, m)
+	server := httptest.NewServer(e)
+	defer server.Close()
+	endpoint := server.URL + "/rollup"
+	ctx := context.Background()
+
+	invalid := map[string]string{
+		"unknown": "",
+		"notice":  `{"payload": "0x00"}`, // no input
+		"report":  `{"data": "0x00"}`,
+		"voucher": `not json`,
+		"finish":  "",
+	}
+	for command, stdin := range invalid {
+		var stdout bytes.Buffer
+		err := Run(ctx, endpoint, command, strings.NewReader(stdin), &stdout)
+		assert.NotNil(t, err, command)
+		assert.Empty(t, stdout.String(), command)
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+// This pkg implements a local sequencer that receives signed messages from the users.
+// Instead of reading the inputs from the InputBox in L1, the sequencer verifies the EIP-712
+// signature of each message and adds it to the model as an advance input.
+package sequencer
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/labstack/echo/v4"
+)
+
+// Default chain id in the EIP-712 domain.
+const DefaultChainID = 31337
+
+// Name and version in the EIP-712 domain.
+const (
+	DomainName    = "Cartesi"
+	DomainVersion = "0.1.0"
+)
+
+// Primary type of the EIP-712 message.
+const MessageType = "CartesiMessage"
+
+// Offset of the recovery id in the signatures produced by wallets.
+const recoveryOffset = 27
+
+var (
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrInvalidApp       = errors.New("invalid app")
+	ErrInvalidNonce     = errors.New("invalid nonce")
+)
+
+// Message signed by the user.
+type Message struct {
+	App   common.Address `json:"app"`
+	Nonce uint64         `json:"nonce"`
+	Data  hexutil.Bytes  `json:"data"`
+}
+
+// Message with the EIP-712 signature of the sender.
+type Transaction struct {
+	Message   Message       `json:"message"`
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// Sequencer that orders the messages by arrival and adds them to the model.
+// The L1 inputs and the sequenced inputs share the same input index sequence; a sequenced
+// input takes the block number of the last input, so the block numbers never decrease.
+type Sequencer struct {
+	model       *model.NonodoModel
+	chainID     uint64
+	application common.Address
+
+	mutex  sync.Mutex
+	nonces map[common.Address]uint64
+}
+
+func NewSequencer(
+	model *model.NonodoModel,
+	chainID uint64,
+	application common.Address,
+) *Sequencer {
+	return &Sequencer{
+		model:       model,
+		chainID:     chainID,
+		application: application,
+		nonces:      make(map[common.Address]uint64),
+	}
+}
+
+// Get the nonce expected in the next message of the sender.
+func (s *Sequencer) Nonce(sender common.Address) uint64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.nonces[sender]
+}
+
+// Verify the transaction and add it to the model as an advance input.
+// Return the input index.
+func (s *Sequencer) Submit(tx Transaction) (int, error) {
+	sender, err := s.recover(tx)
+	if err != nil {
+		return 0, err
+	}
+	if tx.Message.App != s.application {
+		return 0, fmt.Errorf("%w: expected %v", ErrInvalidApp, s.application)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	expected := s.nonces[sender]
+	if tx.Message.Nonce != expected {
+		return 0, fmt.Errorf("%w: expected %v", ErrInvalidNonce, expected)
+	}
+	index := s.model.AddSequencedInput(sender, tx.Message.Data, time.Now())
+	s.nonces[sender] = expected + 1
+	slog.Info("sequencer: sequenced message", "index", index, "sender", sender,
+		"nonce", tx.Message.Nonce)
+	return index, nil
+}
+
+// Recover the address that signed the transaction.
+func (s *Sequencer) recover(tx Transaction) (common.Address, error) {
+	if len(tx.Signature) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("%w: invalid length", ErrInvalidSignature)
+	}
+	hash, err := Hash(s.chainID, tx.Message)
+	if err != nil {
+		return common.Address{}, err
+	}
+	signature := make([]byte, crypto.SignatureLength)
+	copy(signature, tx.Signature)
+	if signature[crypto.RecoveryIDOffset] >= recoveryOffset {
+		signature[crypto.RecoveryIDOffset] -= recoveryOffset
+	}
+	publicKey, err := crypto.SigToPub(hash, signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
+
+// Compute the EIP-712 hash of the message, which the sender signs.
+func Hash(chainID uint64, message Message) ([]byte, error) {
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+			},
+			MessageType: {
+				{Name: "app", Type: "address"},
+				{Name: "nonce", Type: "uint64"},
+				{Name: "data", Type: "bytes"},
+			},
+		},
+		PrimaryType: MessageType,
+		Domain: apitypes.TypedDataDomain{
+			Name:    DomainName,
+			Version: DomainVersion,
+			ChainId: (*math.HexOrDecimal256)(new(big.Int).SetUint64(chainID)),
+		},
+		Message: apitypes.TypedDataMessage{
+			"app":   message.App.Hex(),
+			"nonce": new(big.Int).SetUint64(message.Nonce).String(),
+			"data":  hexutil.Encode(message.Data),
+		},
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("sequencer: failed to hash message: %w", err)
+	}
+	return hash, nil
+}
+
+// Register the endpoints that submit the transactions and query the nonces.
+func (s *Sequencer) Register(e *echo.Echo) {
+	e.POST("/sequencer/transactions", func(c echo.Context) error {
+		var tx Transaction
+		if err := (&echo.DefaultBinder{}).BindBody(c, &tx); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		index, err := s.Submit(tx)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		return c.JSON(http.StatusOK, map[string]int{"index": index})
+	})
+	e.GET("/sequencer/nonce", func(c echo.Context) error {
+		sender := c.QueryParam("sender")
+		if !common.IsHexAddress(sender) {
+			return c.String(http.StatusBadRequest, "invalid sender")
+		}
+		nonce := s.Nonce(common.HexToAddress(sender))
+		return c.JSON(http.StatusOK, map[string]uint64{"nonce": nonce})
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package sequencer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var application = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+// Sign the message with the key, as a wallet does.
+func sign(t *testing.T, key []byte, message Message) Transaction {
+	privateKey, err := crypto.ToECDSA(key)
+	require.Nil(t, err)
+	hash, err := Hash(DefaultChainID, message)
+	require.Nil(t, err)
+	signature, err := crypto.Sign(hash, privateKey)
+	require.Nil(t, err)
+	signature[crypto.RecoveryIDOffset] += recoveryOffset
+	return Transaction{Message: message, Signature: signature}
+}
+
+func TestSequencer(t *testing.T) {
+	m := model.NewNonodoModel()
+	s := NewSequencer(m, DefaultChainID, application)
+	key := bytes.Repeat([]byte{1}, common.HashLength)
+	privateKey, err := crypto.ToECDSA(key)
+	require.Nil(t, err)
+	sender := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	index, err := s.Submit(sign(t, key, Message{App: application, Nonce: 0, Data: []byte("a")}))
+	require.Nil(t, err)
+	assert.Equal(t, 0, index)
+	assert.Equal(t, uint64(1), s.Nonce(sender))
+
+	// replayed and skipped nonces
+	_, err = s.Submit(sign(t, key, Message{App: application, Nonce: 0, Data: []byte("a")}))
+	assert.ErrorIs(t, err, ErrInvalidNonce)
+	_, err = s.Submit(sign(t, key, Message{App: application, Nonce: 2, Data: []byte("b")}))
+	assert.ErrorIs(t, err, ErrInvalidNonce)
+
+	// message to other app
+	_, err = s.Submit(sign(t, key, Message{App: common.Address{}, Nonce: 1}))
+	assert.ErrorIs(t, err, ErrInvalidApp)
+
+	// tampered message recovers another sender, which has a different nonce
+	tx := sign(t, key, Message{App: application, Nonce: 1, Data: []byte("b")})
+	tx.Message.Data = []byte("c")
+	_, err = s.Submit(tx)
+	assert.ErrorIs(t, err, ErrInvalidNonce)
+	tx.Signature = tx.Signature[:10]
+	_, err = s.Submit(tx)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	// L1 input interleaved with the sequenced inputs
+	m.AddAdvanceInput(common.Address{}, []byte("l1"), 5, time.Now())
+	index, err = s.Submit(sign(t, key, Message{App: application, Nonce: 1, Data: []byte("b")}))
+	require.Nil(t, err)
+	assert.Equal(t, 2, index)
+
+	inputs := m.GetInputs(model.InputFilter{}, 0, 100)
+	require.Len(t, inputs, 3)
+	assert.Equal(t, sender, inputs[0].MsgSender)
+	assert.Equal(t, []byte("a"), inputs[0].Payload)
+	assert.Equal(t, []byte("l1"), inputs[1].Payload)
+	assert.Equal(t, sender, inputs[2].MsgSender)
+	assert.Equal(t, []byte("b"), inputs[2].Payload)
+	assert.Equal(t, uint64(5), inputs[2].BlockNumber)
+}
+
+func TestSequencerEndpoints(t *testing.T) {
+	m := model.NewNonodoModel()
+	e := echo.New()
+	NewSequencer(m, DefaultChainID, application).Register(e)
+	server := httptest.NewServer(e)
+	defer server.Close()
+	key := bytes.Repeat([]byte{2}, common.HashLength)
+	privateKey, err := crypto.ToECDSA(key)
+	require.Nil(t, err)
+	sender := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	getNonce := func() string {
+		resp, err := http.Get(fmt.Sprintf("%v/sequencer/nonce?sender=%v", server.URL, sender))
+		require.Nil(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body, err := io.ReadAll(resp.Body)
+		require.Nil(t, err)
+		return string(bytes.TrimSpace(body))
+	}
+	submit := func(tx Transaction) (int, string) {
+		body, err := json.Marshal(tx)
+		require.Nil(t, err)
+		resp, err := http.Post(server.URL+"/sequencer/transactions", echo.MIMEApplicationJSON,
+			bytes.NewReader(body))
+		require.Nil(t, err)
+		defer resp.Body.Close()
+		respBody, err := io.ReadAll(resp.Body)
+		require.Nil(t, err)
+		return resp.StatusCode, string(bytes.TrimSpace(respBody))
+	}
+
+	assert.Equal(t, `{"nonce":0}`, getNonce())
+	status, body := submit(sign(t, key, Message{App: application, Nonce: 0, Data: []byte("a")}))
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `{"index":0}`, body)
+	assert.Equal(t, `{"nonce":1}`, getNonce())
+
+	status, body = submit(sign(t, key, Message{App: application, Nonce: 0, Data: []byte("a")}))
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, "invalid nonce: expected 1", body)
+
+	resp, err := http.Get(server.URL + "/sequencer/nonce?sender=invalid")
+	require.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
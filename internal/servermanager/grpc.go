@@ -0,0 +1,279 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package servermanager
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/servermanager/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Default id of the server-manager session.
+const DefaultSessionID = "nonodo"
+
+// Interval between checks of the epoch status while the machine processes an advance.
+const EpochPollInterval = 50 * time.Millisecond
+
+// Cycles of the session started by nonodo.
+// The machine may run up to the max cycles for each input, checking the deadlines after each
+// increment.
+const (
+	SessionMaxCycles       = math.MaxUint64 >> 2
+	SessionCyclesIncrement = 1 << 22
+)
+
+// Deadlines of the session started by nonodo.
+const (
+	SessionCheckinDeadline   = 5 * time.Second
+	SessionInputDeadline     = 3 * time.Minute
+	SessionIncrementDeadline = 10 * time.Second
+	SessionMachineDeadline   = 5 * time.Second
+	SessionStoreDeadline     = 3 * time.Minute
+	SessionFastDeadline      = 5 * time.Second
+)
+
+// Backend that talks to the server-manager through gRPC.
+// Nonodo keeps all inputs in the first epoch, so it never finishes an epoch.
+type GrpcBackend struct {
+	address   string
+	sessionID string
+
+	// If set, start a session with the machine snapshot in this directory.
+	machineDirectory string
+
+	mutex  sync.Mutex
+	conn   *grpc.ClientConn
+	client pb.ServerManagerClient
+
+	// Whether nonodo started the session.
+	started bool
+
+	// Whether the session should restart before the next request.
+	reset bool
+}
+
+// Create the backend that connects to the server-manager in the address.
+// If the machine directory is empty, the session should already exist in the server-manager.
+func NewGrpcBackend(address string, sessionID string, machineDirectory string) *GrpcBackend {
+	return &GrpcBackend{
+		address:          address,
+		sessionID:        sessionID,
+		machineDirectory: machineDirectory,
+	}
+}
+
+// Close the connection to the server-manager.
+func (b *GrpcBackend) Close() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.conn == nil {
+		return nil
+	}
+	err := b.conn.Close()
+	b.conn = nil
+	b.client = nil
+	return err
+}
+
+// Restart the session before the next request, so the machine processes the inputs from scratch.
+// This should be called after a rollback, while the worker is stopped.
+// If nonodo didn't start the session, it can't restart it, so the next request fails.
+func (b *GrpcBackend) Reset() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.conn != nil {
+		_ = b.conn.Close()
+		b.conn = nil
+		b.client = nil
+	}
+	b.reset = true
+}
+
+func (b *GrpcBackend) Advance(ctx context.Context, input model.AdvanceInput) (Result, error) {
+	client, err := b.connect(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+	request := &pb.AdvanceStateRequest{
+		SessionId:         b.sessionID,
+		CurrentInputIndex: uint64(input.Index),
+		InputMetadata: &pb.InputMetadata{
+			MsgSender:   &pb.Address{Data: input.MsgSender[:]},
+			BlockNumber: input.BlockNumber,
+			Timestamp:   uint64(input.Timestamp.Unix()),
+			InputIndex:  uint64(input.Index),
+		},
+		InputPayload: input.Payload,
+	}
+	if _, err := client.AdvanceState(ctx, request); err != nil {
+		return Result{}, fmt.Errorf("failed to call AdvanceState: %w", err)
+	}
+
+	// the server-manager processes the advance asynchronously
+	for {
+		request := &pb.GetEpochStatusRequest{SessionId: b.sessionID}
+		response, err := client.GetEpochStatus(ctx, request)
+		if err != nil {
+			return Result{}, fmt.Errorf("failed to call GetEpochStatus: %w", err)
+		}
+		for _, processed := range response.GetProcessedInputs() {
+			if processed.GetInputIndex() == uint64(input.Index) {
+				return newResult(processed.GetStatus(), processed.GetAcceptedData(),
+					processed.GetReports(), processed.GetExceptionData()), nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return Result{}, ctx.Err()
+		case <-time.After(EpochPollInterval):
+		}
+	}
+}
+
+func (b *GrpcBackend) Inspect(ctx context.Context, input model.InspectInput) (Result, error) {
+	client, err := b.connect(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+	request := &pb.InspectStateRequest{
+		SessionId:    b.sessionID,
+		QueryPayload: input.Payload,
+	}
+	response, err := client.InspectState(ctx, request)
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to call InspectState: %w", err)
+	}
+	return newResult(response.GetStatus(), nil, response.GetReports(),
+		response.GetExceptionData()), nil
+}
+
+// Connect to the server-manager and start the session if needed.
+func (b *GrpcBackend) connect(ctx context.Context) (pb.ServerManagerClient, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.client == nil {
+		conn, err := grpc.Dial(b.address,
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect: %w", err)
+		}
+		b.conn = conn
+		b.client = pb.NewServerManagerClient(conn)
+	}
+	if b.reset {
+		if b.machineDirectory == "" {
+			return nil, fmt.Errorf("failed to replay the inputs: session %v was not started "+
+				"from a machine snapshot", b.sessionID)
+		}
+		if b.started {
+			request := &pb.EndSessionRequest{SessionId: b.sessionID}
+			if _, err := b.client.EndSession(ctx, request); err != nil {
+				return nil, fmt.Errorf("failed to call EndSession: %w", err)
+			}
+			b.started = false
+			slog.Info("server-manager: ended session to replay the inputs",
+				"session", b.sessionID)
+		}
+		b.reset = false
+	}
+	if b.machineDirectory != "" && !b.started {
+		request := newStartSessionRequest(b.sessionID, b.machineDirectory)
+		if _, err := b.client.StartSession(ctx, request); err != nil {
+			return nil, fmt.Errorf("failed to call StartSession: %w", err)
+		}
+		b.started = true
+		slog.Info("server-manager: started session", "session", b.sessionID,
+			"machine", b.machineDirectory)
+	}
+	return b.client, nil
+}
+
+// Create the request that starts the session with the machine snapshot in the directory.
+func newStartSessionRequest(sessionID string, machineDirectory string) *pb.StartSessionRequest {
+	return &pb.StartSessionRequest{
+		SessionId:        sessionID,
+		MachineDirectory: machineDirectory,
+		ServerCycles: &pb.CyclesConfig{
+			MaxAdvanceState:       SessionMaxCycles,
+			AdvanceStateIncrement: SessionCyclesIncrement,
+			MaxInspectState:       SessionMaxCycles,
+			InspectStateIncrement: SessionCyclesIncrement,
+		},
+		ServerDeadline: &pb.DeadlineConfig{
+			Checkin:               uint64(SessionCheckinDeadline.Milliseconds()),
+			AdvanceState:          uint64(SessionInputDeadline.Milliseconds()),
+			AdvanceStateIncrement: uint64(SessionIncrementDeadline.Milliseconds()),
+			InspectState:          uint64(SessionInputDeadline.Milliseconds()),
+			InspectStateIncrement: uint64(SessionIncrementDeadline.Milliseconds()),
+			Machine:               uint64(SessionMachineDeadline.Milliseconds()),
+			Store:                 uint64(SessionStoreDeadline.Milliseconds()),
+			Fast:                  uint64(SessionFastDeadline.Milliseconds()),
+		},
+		Runtime: &pb.MachineRuntimeConfig{
+			Concurrency: &pb.ConcurrencyRuntimeConfig{},
+		},
+	}
+}
+
+// Convert the result of the server-manager.
+func newResult(
+	status pb.CompletionStatus,
+	accepted *pb.AcceptedData,
+	reports []*pb.Report,
+	exception []byte,
+) Result {
+	var result Result
+	for _, voucher := range accepted.GetVouchers() {
+		result.Vouchers = append(result.Vouchers, Voucher{
+			Destination: common.BytesToAddress(voucher.GetDestination().GetData()),
+			Payload:     voucher.GetPayload(),
+		})
+	}
+	for _, notice := range accepted.GetNotices() {
+		result.Notices = append(result.Notices, notice.GetPayload())
+	}
+	for _, report := range reports {
+		result.Reports = append(result.Reports, report.GetPayload())
+	}
+	switch status {
+	case pb.CompletionStatus_ACCEPTED:
+		result.Status = model.CompletionStatusAccepted
+	case pb.CompletionStatus_REJECTED:
+		result.Status = model.CompletionStatusRejected
+	case pb.CompletionStatus_EXCEPTION:
+		result.Status = model.CompletionStatusException
+		result.Exception = exception
+		if result.Exception == nil {
+			result.Exception = []byte{}
+		}
+	case pb.CompletionStatus_MACHINE_HALTED, pb.CompletionStatus_CYCLE_LIMIT_EXCEEDED,
+		pb.CompletionStatus_TIME_LIMIT_EXCEEDED,
+		pb.CompletionStatus_PAYLOAD_LENGTH_LIMIT_EXCEEDED:
+		result.Status = model.CompletionStatusMachineHalted
+		result.HaltReason = describeStatus(status)
+	default:
+		// treat the statuses added in newer versions as halts
+		result.Status = model.CompletionStatusMachineHalted
+		result.HaltReason = describeStatus(status)
+	}
+	return result
+}
+
+// Describe the completion status in lower case, like "cycle limit exceeded".
+func describeStatus(status pb.CompletionStatus) string {
+	return strings.ToLower(strings.ReplaceAll(status.String(), "_", " "))
+}
@@ -0,0 +1,262 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package servermanager
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/servermanager/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Fake server-manager that echoes the advance payload as a voucher, a notice, and a report.
+type fakeServerManager struct {
+	pb.UnimplementedServerManagerServer
+	mutex     sync.Mutex
+	session   *pb.StartSessionRequest
+	processed []*pb.ProcessedInput
+}
+
+func (s *fakeServerManager) StartSession(
+	ctx context.Context,
+	request *pb.StartSessionRequest,
+) (*pb.StartSessionResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.session != nil {
+		return nil, status.Error(codes.AlreadyExists, "session already exists")
+	}
+	s.session = request
+	return &pb.StartSessionResponse{}, nil
+}
+
+func (s *fakeServerManager) EndSession(
+	ctx context.Context,
+	request *pb.EndSessionRequest,
+) (*pb.Void, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.session == nil || request.GetSessionId() != s.session.GetSessionId() {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+	s.session = nil
+	s.processed = nil
+	return &pb.Void{}, nil
+}
+
+func (s *fakeServerManager) AdvanceState(
+	ctx context.Context,
+	request *pb.AdvanceStateRequest,
+) (*pb.Void, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.session == nil || request.GetSessionId() != s.session.GetSessionId() {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+	if request.GetCurrentInputIndex() != uint64(len(s.processed)) {
+		return nil, status.Error(codes.InvalidArgument, "wrong input index")
+	}
+	payload := request.GetInputPayload()
+	input := &pb.ProcessedInput{
+		InputIndex: request.GetCurrentInputIndex(),
+		Reports:    []*pb.Report{{Payload: payload}},
+	}
+	switch string(payload) {
+	case "reject":
+		input.Status = pb.CompletionStatus_REJECTED
+	case "exception":
+		input.Status = pb.CompletionStatus_EXCEPTION
+		input.ProcessedInputOneOf = &pb.ProcessedInput_ExceptionData{ExceptionData: payload}
+	case "halt":
+		input.Status = pb.CompletionStatus_CYCLE_LIMIT_EXCEEDED
+	default:
+		input.Status = pb.CompletionStatus_ACCEPTED
+		input.ProcessedInputOneOf = &pb.ProcessedInput_AcceptedData{
+			AcceptedData: &pb.AcceptedData{
+				Vouchers: []*pb.Voucher{{
+					Destination: request.GetInputMetadata().GetMsgSender(),
+					Payload:     payload,
+				}},
+				Notices: []*pb.Notice{{Payload: payload}},
+			},
+		}
+	}
+	// the input is processed after the first epoch status request
+	go func() {
+		time.Sleep(EpochPollInterval / 2)
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.processed = append(s.processed, input)
+	}()
+	return &pb.Void{}, nil
+}
+
+func (s *fakeServerManager) GetEpochStatus(
+	ctx context.Context,
+	request *pb.GetEpochStatusRequest,
+) (*pb.GetEpochStatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return &pb.GetEpochStatusResponse{
+		SessionId:       request.GetSessionId(),
+		ProcessedInputs: append([]*pb.ProcessedInput{}, s.processed...),
+	}, nil
+}
+
+func (s *fakeServerManager) InspectState(
+	ctx context.Context,
+	request *pb.InspectStateRequest,
+) (*pb.InspectStateResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return &pb.InspectStateResponse{
+		SessionId:           request.GetSessionId(),
+		ProcessedInputCount: uint64(len(s.processed)),
+		Status:              pb.CompletionStatus_ACCEPTED,
+		Reports:             []*pb.Report{{Payload: []byte(fmt.Sprint(len(s.processed)))}},
+	}, nil
+}
+
+// Start the fake server-manager and return its address.
+func startFakeServerManager(t *testing.T, s *fakeServerManager) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	server := grpc.NewServer()
+	pb.RegisterServerManagerServer(server, s)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+func TestGrpcBackend(t *testing.T) {
+	fake := &fakeServerManager{}
+	address := startFakeServerManager(t, fake)
+	backend := NewGrpcBackend(address, DefaultSessionID, "/machine")
+	defer backend.Close()
+	ctx := context.Background()
+	sender := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	advance := func(index int, payload string) Result {
+		result, err := backend.Advance(ctx, model.AdvanceInput{
+			Index:       index,
+			MsgSender:   sender,
+			Payload:     []byte(payload),
+			BlockNumber: 1,
+			Timestamp:   time.Unix(2, 0),
+		})
+		require.Nil(t, err)
+		return result
+	}
+
+	result := advance(0, "hello")
+	assert.Equal(t, model.CompletionStatusAccepted, result.Status)
+	assert.Equal(t, []Voucher{{Destination: sender, Payload: []byte("hello")}},
+		result.Vouchers)
+	assert.Equal(t, [][]byte{[]byte("hello")}, result.Notices)
+	assert.Equal(t, [][]byte{[]byte("hello")}, result.Reports)
+
+	result = advance(1, "reject")
+	assert.Equal(t, model.CompletionStatusRejected, result.Status)
+
+	result = advance(2, "exception")
+	assert.Equal(t, model.CompletionStatusException, result.Status)
+	assert.Equal(t, []byte("exception"), result.Exception)
+
+	result = advance(3, "halt")
+	assert.Equal(t, model.CompletionStatusMachineHalted, result.Status)
+	assert.Equal(t, "cycle limit exceeded", result.HaltReason)
+
+	result, err := backend.Inspect(ctx, model.InspectInput{Payload: []byte("query")})
+	require.Nil(t, err)
+	assert.Equal(t, model.CompletionStatusAccepted, result.Status)
+	assert.Equal(t, [][]byte{[]byte("4")}, result.Reports)
+
+	// the server-manager rejects the out-of-order input
+	_, err = backend.Advance(ctx, model.AdvanceInput{Index: 10})
+	assert.NotNil(t, err)
+}
+
+func TestGrpcBackendStartsSession(t *testing.T) {
+	fake := &fakeServerManager{}
+	address := startFakeServerManager(t, fake)
+	backend := NewGrpcBackend(address, DefaultSessionID, "/machine")
+	defer backend.Close()
+
+	_, err := backend.Inspect(context.Background(), model.InspectInput{})
+	require.Nil(t, err)
+	session := fake.session
+	require.NotNil(t, session)
+	assert.Equal(t, DefaultSessionID, session.GetSessionId())
+	assert.Equal(t, "/machine", session.GetMachineDirectory())
+	assert.Equal(t, uint64(SessionMaxCycles), session.GetServerCycles().GetMaxAdvanceState())
+	assert.Equal(t, uint64(SessionCyclesIncrement),
+		session.GetServerCycles().GetInspectStateIncrement())
+	assert.Equal(t, uint64(SessionInputDeadline.Milliseconds()),
+		session.GetServerDeadline().GetAdvanceState())
+	assert.Equal(t, uint64(SessionFastDeadline.Milliseconds()),
+		session.GetServerDeadline().GetFast())
+	assert.NotNil(t, session.GetRuntime())
+}
+
+func TestGrpcBackendReset(t *testing.T) {
+	fake := &fakeServerManager{}
+	address := startFakeServerManager(t, fake)
+	backend := NewGrpcBackend(address, DefaultSessionID, "/machine")
+	defer backend.Close()
+	ctx := context.Background()
+
+	advance := func(index int, payload string) (Result, error) {
+		return backend.Advance(ctx, model.AdvanceInput{Index: index, Payload: []byte(payload)})
+	}
+	for i, payload := range []string{"first", "orphaned"} {
+		_, err := advance(i, payload)
+		require.Nil(t, err)
+	}
+
+	// the session keeps the orphaned input, so the replay fails without the reset
+	_, err := advance(0, "first")
+	assert.ErrorContains(t, err, "wrong input index")
+
+	// after the rollback, the session restarts from the snapshot
+	backend.Reset()
+	for i, payload := range []string{"first", "replacement"} {
+		result, err := advance(i, payload)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte(payload)}, result.Notices)
+	}
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	assert.Len(t, fake.processed, 2)
+}
+
+func TestGrpcBackendResetExistingSession(t *testing.T) {
+	fake := &fakeServerManager{
+		session: &pb.StartSessionRequest{SessionId: DefaultSessionID},
+	}
+	address := startFakeServerManager(t, fake)
+	backend := NewGrpcBackend(address, DefaultSessionID, "")
+	defer backend.Close()
+	ctx := context.Background()
+
+	_, err := backend.Advance(ctx, model.AdvanceInput{Index: 0})
+	require.Nil(t, err)
+
+	// nonodo can't restart the session without the machine snapshot
+	backend.Reset()
+	_, err = backend.Advance(ctx, model.AdvanceInput{Index: 0})
+	assert.ErrorContains(t, err, "failed to replay the inputs")
+}
@@ -0,0 +1,549 @@
+// Subset of cartesi-machine.proto from github.com/cartesi/grpc-interfaces, which nonodo uses to
+// generate the server-manager client in internal/servermanager/pb.
+// It only has the messages referenced by server-manager.proto, keeping their field numbers.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: cartesi-machine.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Void struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Void) Reset() {
+	*x = Void{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cartesi_machine_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Void) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Void) ProtoMessage() {}
+
+func (x *Void) ProtoReflect() protoreflect.Message {
+	mi := &file_cartesi_machine_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Void.ProtoReflect.Descriptor instead.
+func (*Void) Descriptor() ([]byte, []int) {
+	return file_cartesi_machine_proto_rawDescGZIP(), []int{0}
+}
+
+type Hash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Hash) Reset() {
+	*x = Hash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cartesi_machine_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hash) ProtoMessage() {}
+
+func (x *Hash) ProtoReflect() protoreflect.Message {
+	mi := &file_cartesi_machine_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hash.ProtoReflect.Descriptor instead.
+func (*Hash) Descriptor() ([]byte, []int) {
+	return file_cartesi_machine_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Hash) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type MerkleTreeProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetAddress  uint64  `protobuf:"varint,1,opt,name=target_address,json=targetAddress,proto3" json:"target_address,omitempty"`
+	Log2TargetSize uint64  `protobuf:"varint,2,opt,name=log2_target_size,json=log2TargetSize,proto3" json:"log2_target_size,omitempty"`
+	TargetHash     *Hash   `protobuf:"bytes,3,opt,name=target_hash,json=targetHash,proto3" json:"target_hash,omitempty"`
+	Log2RootSize   uint64  `protobuf:"varint,4,opt,name=log2_root_size,json=log2RootSize,proto3" json:"log2_root_size,omitempty"`
+	RootHash       *Hash   `protobuf:"bytes,5,opt,name=root_hash,json=rootHash,proto3" json:"root_hash,omitempty"`
+	SiblingHashes  []*Hash `protobuf:"bytes,6,rep,name=sibling_hashes,json=siblingHashes,proto3" json:"sibling_hashes,omitempty"`
+}
+
+func (x *MerkleTreeProof) Reset() {
+	*x = MerkleTreeProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cartesi_machine_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MerkleTreeProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerkleTreeProof) ProtoMessage() {}
+
+func (x *MerkleTreeProof) ProtoReflect() protoreflect.Message {
+	mi := &file_cartesi_machine_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerkleTreeProof.ProtoReflect.Descriptor instead.
+func (*MerkleTreeProof) Descriptor() ([]byte, []int) {
+	return file_cartesi_machine_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MerkleTreeProof) GetTargetAddress() uint64 {
+	if x != nil {
+		return x.TargetAddress
+	}
+	return 0
+}
+
+func (x *MerkleTreeProof) GetLog2TargetSize() uint64 {
+	if x != nil {
+		return x.Log2TargetSize
+	}
+	return 0
+}
+
+func (x *MerkleTreeProof) GetTargetHash() *Hash {
+	if x != nil {
+		return x.TargetHash
+	}
+	return nil
+}
+
+func (x *MerkleTreeProof) GetLog2RootSize() uint64 {
+	if x != nil {
+		return x.Log2RootSize
+	}
+	return 0
+}
+
+func (x *MerkleTreeProof) GetRootHash() *Hash {
+	if x != nil {
+		return x.RootHash
+	}
+	return nil
+}
+
+func (x *MerkleTreeProof) GetSiblingHashes() []*Hash {
+	if x != nil {
+		return x.SiblingHashes
+	}
+	return nil
+}
+
+type ConcurrencyRuntimeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdateMerkleTree uint64 `protobuf:"varint,1,opt,name=update_merkle_tree,json=updateMerkleTree,proto3" json:"update_merkle_tree,omitempty"`
+}
+
+func (x *ConcurrencyRuntimeConfig) Reset() {
+	*x = ConcurrencyRuntimeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cartesi_machine_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConcurrencyRuntimeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConcurrencyRuntimeConfig) ProtoMessage() {}
+
+func (x *ConcurrencyRuntimeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cartesi_machine_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConcurrencyRuntimeConfig.ProtoReflect.Descriptor instead.
+func (*ConcurrencyRuntimeConfig) Descriptor() ([]byte, []int) {
+	return file_cartesi_machine_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConcurrencyRuntimeConfig) GetUpdateMerkleTree() uint64 {
+	if x != nil {
+		return x.UpdateMerkleTree
+	}
+	return 0
+}
+
+type HTIFRuntimeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NoConsolePutchar bool `protobuf:"varint,1,opt,name=no_console_putchar,json=noConsolePutchar,proto3" json:"no_console_putchar,omitempty"`
+}
+
+func (x *HTIFRuntimeConfig) Reset() {
+	*x = HTIFRuntimeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cartesi_machine_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HTIFRuntimeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTIFRuntimeConfig) ProtoMessage() {}
+
+func (x *HTIFRuntimeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cartesi_machine_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTIFRuntimeConfig.ProtoReflect.Descriptor instead.
+func (*HTIFRuntimeConfig) Descriptor() ([]byte, []int) {
+	return file_cartesi_machine_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HTIFRuntimeConfig) GetNoConsolePutchar() bool {
+	if x != nil {
+		return x.NoConsolePutchar
+	}
+	return false
+}
+
+type MachineRuntimeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Concurrency       *ConcurrencyRuntimeConfig `protobuf:"bytes,1,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+	Htif              *HTIFRuntimeConfig        `protobuf:"bytes,2,opt,name=htif,proto3" json:"htif,omitempty"`
+	SkipRootHashCheck bool                      `protobuf:"varint,3,opt,name=skip_root_hash_check,json=skipRootHashCheck,proto3" json:"skip_root_hash_check,omitempty"`
+	SkipVersionCheck  bool                      `protobuf:"varint,4,opt,name=skip_version_check,json=skipVersionCheck,proto3" json:"skip_version_check,omitempty"`
+}
+
+func (x *MachineRuntimeConfig) Reset() {
+	*x = MachineRuntimeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cartesi_machine_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MachineRuntimeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MachineRuntimeConfig) ProtoMessage() {}
+
+func (x *MachineRuntimeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_cartesi_machine_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MachineRuntimeConfig.ProtoReflect.Descriptor instead.
+func (*MachineRuntimeConfig) Descriptor() ([]byte, []int) {
+	return file_cartesi_machine_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MachineRuntimeConfig) GetConcurrency() *ConcurrencyRuntimeConfig {
+	if x != nil {
+		return x.Concurrency
+	}
+	return nil
+}
+
+func (x *MachineRuntimeConfig) GetHtif() *HTIFRuntimeConfig {
+	if x != nil {
+		return x.Htif
+	}
+	return nil
+}
+
+func (x *MachineRuntimeConfig) GetSkipRootHashCheck() bool {
+	if x != nil {
+		return x.SkipRootHashCheck
+	}
+	return false
+}
+
+func (x *MachineRuntimeConfig) GetSkipVersionCheck() bool {
+	if x != nil {
+		return x.SkipVersionCheck
+	}
+	return false
+}
+
+var File_cartesi_machine_proto protoreflect.FileDescriptor
+
+var file_cartesi_machine_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x63, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x2d, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x22,
+	0x1a, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xaf, 0x02, 0x0a, 0x0f,
+	0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x67, 0x32, 0x5f, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x6c, 0x6f, 0x67, 0x32, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x35, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0a, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x32, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6c, 0x6f, 0x67, 0x32, 0x52, 0x6f, 0x6f, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x31, 0x0a,
+	0x09, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x3b, 0x0a, 0x0e, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65,
+	0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x0d,
+	0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x48, 0x0a,
+	0x18, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x22, 0x41, 0x0a, 0x11, 0x48, 0x54, 0x49, 0x46, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x12,
+	0x6e, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x70, 0x75, 0x74, 0x63, 0x68,
+	0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6e, 0x6f, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x65, 0x50, 0x75, 0x74, 0x63, 0x68, 0x61, 0x72, 0x22, 0xf8, 0x01, 0x0a, 0x14, 0x4d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65,
+	0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x35, 0x0a, 0x04, 0x68, 0x74, 0x69, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x48,
+	0x54, 0x49, 0x46, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x04, 0x68, 0x74, 0x69, 0x66, 0x12, 0x2f, 0x0a, 0x14, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x61,
+	0x73, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x6b, 0x69, 0x70, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cartesi_machine_proto_rawDescOnce sync.Once
+	file_cartesi_machine_proto_rawDescData = file_cartesi_machine_proto_rawDesc
+)
+
+func file_cartesi_machine_proto_rawDescGZIP() []byte {
+	file_cartesi_machine_proto_rawDescOnce.Do(func() {
+		file_cartesi_machine_proto_rawDescData = protoimpl.X.CompressGZIP(file_cartesi_machine_proto_rawDescData)
+	})
+	return file_cartesi_machine_proto_rawDescData
+}
+
+var file_cartesi_machine_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cartesi_machine_proto_goTypes = []interface{}{
+	(*Void)(nil),                     // 0: CartesiMachine.Void
+	(*Hash)(nil),                     // 1: CartesiMachine.Hash
+	(*MerkleTreeProof)(nil),          // 2: CartesiMachine.MerkleTreeProof
+	(*ConcurrencyRuntimeConfig)(nil), // 3: CartesiMachine.ConcurrencyRuntimeConfig
+	(*HTIFRuntimeConfig)(nil),        // 4: CartesiMachine.HTIFRuntimeConfig
+	(*MachineRuntimeConfig)(nil),     // 5: CartesiMachine.MachineRuntimeConfig
+}
+var file_cartesi_machine_proto_depIdxs = []int32{
+	1, // 0: CartesiMachine.MerkleTreeProof.target_hash:type_name -> CartesiMachine.Hash
+	1, // 1: CartesiMachine.MerkleTreeProof.root_hash:type_name -> CartesiMachine.Hash
+	1, // 2: CartesiMachine.MerkleTreeProof.sibling_hashes:type_name -> CartesiMachine.Hash
+	3, // 3: CartesiMachine.MachineRuntimeConfig.concurrency:type_name -> CartesiMachine.ConcurrencyRuntimeConfig
+	4, // 4: CartesiMachine.MachineRuntimeConfig.htif:type_name -> CartesiMachine.HTIFRuntimeConfig
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cartesi_machine_proto_init() }
+func file_cartesi_machine_proto_init() {
+	if File_cartesi_machine_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_cartesi_machine_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Void); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cartesi_machine_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cartesi_machine_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MerkleTreeProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cartesi_machine_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConcurrencyRuntimeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cartesi_machine_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTIFRuntimeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cartesi_machine_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MachineRuntimeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cartesi_machine_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cartesi_machine_proto_goTypes,
+		DependencyIndexes: file_cartesi_machine_proto_depIdxs,
+		MessageInfos:      file_cartesi_machine_proto_msgTypes,
+	}.Build()
+	File_cartesi_machine_proto = out.File
+	file_cartesi_machine_proto_rawDesc = nil
+	file_cartesi_machine_proto_goTypes = nil
+	file_cartesi_machine_proto_depIdxs = nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+// This binary generates the gRPC stubs of the server-manager.
+// This binary should be called with `go generate` in the parent dir.
+// First, it compiles the protos in api/grpc-interfaces, so it doesn't need protoc.
+// Then, it runs the protoc-gen-go and protoc-gen-go-grpc plugins with the compiled protos.
+// Finally, it stores the stubs in the current directory.
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const protoDir = "../../../api/grpc-interfaces"
+const goPackage = "github.com/gligneul/nonodo/internal/servermanager/pb"
+
+var protoFiles = []string{
+	"versioning.proto",
+	"cartesi-machine.proto",
+	"server-manager.proto",
+}
+
+var plugins = []string{
+	"google.golang.org/protobuf/cmd/protoc-gen-go",
+	"google.golang.org/grpc/cmd/protoc-gen-go-grpc",
+}
+
+func main() {
+	request := compile()
+	for _, plugin := range plugins {
+		response := runPlugin(plugin, request)
+		for _, file := range response.File {
+			const fileMode = 0600
+			err := os.WriteFile(file.GetName(), []byte(file.GetContent()), fileMode)
+			checkErr("write stub file", err)
+			log.Print("generated stub ", file.GetName())
+		}
+	}
+}
+
+// Exit if there is any error.
+func checkErr(context string, err any) {
+	if err != nil {
+		log.Fatal(context, ": ", err)
+	}
+}
+
+// Compile the protos and create the request for the plugins.
+func compile() *pluginpb.CodeGeneratorRequest {
+	log.Print("compiling protos in ", protoDir)
+	compiler := protocompile.Compiler{
+		Resolver: &protocompile.SourceResolver{
+			ImportPaths: []string{protoDir},
+		},
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	files, err := compiler.Compile(context.Background(), protoFiles...)
+	checkErr("compile protos", err)
+
+	// the go package is set by the plugin parameters, so the protos match the upstream ones
+	params := []string{"paths=source_relative"}
+	request := &pluginpb.CodeGeneratorRequest{FileToGenerate: protoFiles}
+	for _, file := range files {
+		params = append(params, fmt.Sprintf("M%v=%v", file.Path(), goPackage))
+		request.ProtoFile = append(request.ProtoFile, toFileProto(file))
+	}
+	request.Parameter = proto.String(strings.Join(params, ","))
+	return request
+}
+
+// Convert the compiled file to the descriptor sent to the plugins.
+func toFileProto(file protoreflect.FileDescriptor) *descriptorpb.FileDescriptorProto {
+	return protodesc.ToFileDescriptorProto(file)
+}
+
+// Run the plugin with the request and return its response.
+func runPlugin(
+	plugin string,
+	request *pluginpb.CodeGeneratorRequest,
+) *pluginpb.CodeGeneratorResponse {
+	log.Print("running ", plugin)
+	input, err := proto.Marshal(request)
+	checkErr("marshal request", err)
+	var output bytes.Buffer
+	cmd := exec.Command("go", "run", plugin)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &output
+	cmd.Stderr = os.Stderr
+	checkErr("run plugin", cmd.Run())
+	var response pluginpb.CodeGeneratorResponse
+	checkErr("unmarshal response", proto.Unmarshal(output.Bytes(), &response))
+	if response.Error != nil {
+		log.Fatal("plugin error: ", response.GetError())
+	}
+	return &response
+}
@@ -0,0 +1,8 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+// This package contains the gRPC stubs of the server-manager.
+// The stubs are generated from the protos in api/grpc-interfaces.
+package pb
+
+//go:generate go run ./generate
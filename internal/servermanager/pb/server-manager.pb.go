@@ -0,0 +1,2580 @@
+// Subset of server-manager.proto from github.com/cartesi/grpc-interfaces, which nonodo uses to
+// generate the server-manager client in internal/servermanager/pb.
+// The messages keep the upstream field numbers; the epoch proofs, which nonodo doesn't use, and
+// the machine config returned by StartSession are omitted, so the client ignores them.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: server-manager.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EpochState int32
+
+const (
+	EpochState_ACTIVE   EpochState = 0
+	EpochState_FINISHED EpochState = 1
+)
+
+// Enum value maps for EpochState.
+var (
+	EpochState_name = map[int32]string{
+		0: "ACTIVE",
+		1: "FINISHED",
+	}
+	EpochState_value = map[string]int32{
+		"ACTIVE":   0,
+		"FINISHED": 1,
+	}
+)
+
+func (x EpochState) Enum() *EpochState {
+	p := new(EpochState)
+	*p = x
+	return p
+}
+
+func (x EpochState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EpochState) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_manager_proto_enumTypes[0].Descriptor()
+}
+
+func (EpochState) Type() protoreflect.EnumType {
+	return &file_server_manager_proto_enumTypes[0]
+}
+
+func (x EpochState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EpochState.Descriptor instead.
+func (EpochState) EnumDescriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{0}
+}
+
+type CompletionStatus int32
+
+const (
+	CompletionStatus_ACCEPTED                      CompletionStatus = 0
+	CompletionStatus_REJECTED                      CompletionStatus = 1
+	CompletionStatus_EXCEPTION                     CompletionStatus = 2
+	CompletionStatus_MACHINE_HALTED                CompletionStatus = 3
+	CompletionStatus_CYCLE_LIMIT_EXCEEDED          CompletionStatus = 4
+	CompletionStatus_TIME_LIMIT_EXCEEDED           CompletionStatus = 5
+	CompletionStatus_PAYLOAD_LENGTH_LIMIT_EXCEEDED CompletionStatus = 6
+)
+
+// Enum value maps for CompletionStatus.
+var (
+	CompletionStatus_name = map[int32]string{
+		0: "ACCEPTED",
+		1: "REJECTED",
+		2: "EXCEPTION",
+		3: "MACHINE_HALTED",
+		4: "CYCLE_LIMIT_EXCEEDED",
+		5: "TIME_LIMIT_EXCEEDED",
+		6: "PAYLOAD_LENGTH_LIMIT_EXCEEDED",
+	}
+	CompletionStatus_value = map[string]int32{
+		"ACCEPTED":                      0,
+		"REJECTED":                      1,
+		"EXCEPTION":                     2,
+		"MACHINE_HALTED":                3,
+		"CYCLE_LIMIT_EXCEEDED":          4,
+		"TIME_LIMIT_EXCEEDED":           5,
+		"PAYLOAD_LENGTH_LIMIT_EXCEEDED": 6,
+	}
+)
+
+func (x CompletionStatus) Enum() *CompletionStatus {
+	p := new(CompletionStatus)
+	*p = x
+	return p
+}
+
+func (x CompletionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompletionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_server_manager_proto_enumTypes[1].Descriptor()
+}
+
+func (CompletionStatus) Type() protoreflect.EnumType {
+	return &file_server_manager_proto_enumTypes[1]
+}
+
+func (x CompletionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompletionStatus.Descriptor instead.
+func (CompletionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{1}
+}
+
+type CyclesConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxAdvanceState       uint64 `protobuf:"varint,1,opt,name=max_advance_state,json=maxAdvanceState,proto3" json:"max_advance_state,omitempty"`
+	AdvanceStateIncrement uint64 `protobuf:"varint,2,opt,name=advance_state_increment,json=advanceStateIncrement,proto3" json:"advance_state_increment,omitempty"`
+	MaxInspectState       uint64 `protobuf:"varint,3,opt,name=max_inspect_state,json=maxInspectState,proto3" json:"max_inspect_state,omitempty"`
+	InspectStateIncrement uint64 `protobuf:"varint,4,opt,name=inspect_state_increment,json=inspectStateIncrement,proto3" json:"inspect_state_increment,omitempty"`
+}
+
+func (x *CyclesConfig) Reset() {
+	*x = CyclesConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CyclesConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CyclesConfig) ProtoMessage() {}
+
+func (x *CyclesConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CyclesConfig.ProtoReflect.Descriptor instead.
+func (*CyclesConfig) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CyclesConfig) GetMaxAdvanceState() uint64 {
+	if x != nil {
+		return x.MaxAdvanceState
+	}
+	return 0
+}
+
+func (x *CyclesConfig) GetAdvanceStateIncrement() uint64 {
+	if x != nil {
+		return x.AdvanceStateIncrement
+	}
+	return 0
+}
+
+func (x *CyclesConfig) GetMaxInspectState() uint64 {
+	if x != nil {
+		return x.MaxInspectState
+	}
+	return 0
+}
+
+func (x *CyclesConfig) GetInspectStateIncrement() uint64 {
+	if x != nil {
+		return x.InspectStateIncrement
+	}
+	return 0
+}
+
+type DeadlineConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checkin               uint64 `protobuf:"varint,1,opt,name=checkin,proto3" json:"checkin,omitempty"`
+	AdvanceState          uint64 `protobuf:"varint,2,opt,name=advance_state,json=advanceState,proto3" json:"advance_state,omitempty"`
+	AdvanceStateIncrement uint64 `protobuf:"varint,3,opt,name=advance_state_increment,json=advanceStateIncrement,proto3" json:"advance_state_increment,omitempty"`
+	InspectState          uint64 `protobuf:"varint,4,opt,name=inspect_state,json=inspectState,proto3" json:"inspect_state,omitempty"`
+	InspectStateIncrement uint64 `protobuf:"varint,5,opt,name=inspect_state_increment,json=inspectStateIncrement,proto3" json:"inspect_state_increment,omitempty"`
+	Machine               uint64 `protobuf:"varint,6,opt,name=machine,proto3" json:"machine,omitempty"`
+	Store                 uint64 `protobuf:"varint,7,opt,name=store,proto3" json:"store,omitempty"`
+	Fast                  uint64 `protobuf:"varint,8,opt,name=fast,proto3" json:"fast,omitempty"`
+}
+
+func (x *DeadlineConfig) Reset() {
+	*x = DeadlineConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadlineConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadlineConfig) ProtoMessage() {}
+
+func (x *DeadlineConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadlineConfig.ProtoReflect.Descriptor instead.
+func (*DeadlineConfig) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeadlineConfig) GetCheckin() uint64 {
+	if x != nil {
+		return x.Checkin
+	}
+	return 0
+}
+
+func (x *DeadlineConfig) GetAdvanceState() uint64 {
+	if x != nil {
+		return x.AdvanceState
+	}
+	return 0
+}
+
+func (x *DeadlineConfig) GetAdvanceStateIncrement() uint64 {
+	if x != nil {
+		return x.AdvanceStateIncrement
+	}
+	return 0
+}
+
+func (x *DeadlineConfig) GetInspectState() uint64 {
+	if x != nil {
+		return x.InspectState
+	}
+	return 0
+}
+
+func (x *DeadlineConfig) GetInspectStateIncrement() uint64 {
+	if x != nil {
+		return x.InspectStateIncrement
+	}
+	return 0
+}
+
+func (x *DeadlineConfig) GetMachine() uint64 {
+	if x != nil {
+		return x.Machine
+	}
+	return 0
+}
+
+func (x *DeadlineConfig) GetStore() uint64 {
+	if x != nil {
+		return x.Store
+	}
+	return 0
+}
+
+func (x *DeadlineConfig) GetFast() uint64 {
+	if x != nil {
+		return x.Fast
+	}
+	return 0
+}
+
+type StartSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId           string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	MachineDirectory    string                `protobuf:"bytes,2,opt,name=machine_directory,json=machineDirectory,proto3" json:"machine_directory,omitempty"`
+	ActiveEpochIndex    uint64                `protobuf:"varint,3,opt,name=active_epoch_index,json=activeEpochIndex,proto3" json:"active_epoch_index,omitempty"`
+	ProcessedInputCount uint64                `protobuf:"varint,4,opt,name=processed_input_count,json=processedInputCount,proto3" json:"processed_input_count,omitempty"`
+	ServerCycles        *CyclesConfig         `protobuf:"bytes,5,opt,name=server_cycles,json=serverCycles,proto3" json:"server_cycles,omitempty"`
+	ServerDeadline      *DeadlineConfig       `protobuf:"bytes,6,opt,name=server_deadline,json=serverDeadline,proto3" json:"server_deadline,omitempty"`
+	Runtime             *MachineRuntimeConfig `protobuf:"bytes,7,opt,name=runtime,proto3" json:"runtime,omitempty"`
+}
+
+func (x *StartSessionRequest) Reset() {
+	*x = StartSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionRequest) ProtoMessage() {}
+
+func (x *StartSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionRequest.ProtoReflect.Descriptor instead.
+func (*StartSessionRequest) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StartSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetMachineDirectory() string {
+	if x != nil {
+		return x.MachineDirectory
+	}
+	return ""
+}
+
+func (x *StartSessionRequest) GetActiveEpochIndex() uint64 {
+	if x != nil {
+		return x.ActiveEpochIndex
+	}
+	return 0
+}
+
+func (x *StartSessionRequest) GetProcessedInputCount() uint64 {
+	if x != nil {
+		return x.ProcessedInputCount
+	}
+	return 0
+}
+
+func (x *StartSessionRequest) GetServerCycles() *CyclesConfig {
+	if x != nil {
+		return x.ServerCycles
+	}
+	return nil
+}
+
+func (x *StartSessionRequest) GetServerDeadline() *DeadlineConfig {
+	if x != nil {
+		return x.ServerDeadline
+	}
+	return nil
+}
+
+func (x *StartSessionRequest) GetRuntime() *MachineRuntimeConfig {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+type StartSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StartSessionResponse) Reset() {
+	*x = StartSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSessionResponse) ProtoMessage() {}
+
+func (x *StartSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSessionResponse.ProtoReflect.Descriptor instead.
+func (*StartSessionResponse) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{3}
+}
+
+type EndSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *EndSessionRequest) Reset() {
+	*x = EndSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndSessionRequest) ProtoMessage() {}
+
+func (x *EndSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndSessionRequest.ProtoReflect.Descriptor instead.
+func (*EndSessionRequest) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EndSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type Address struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Address) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type InputMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgSender   *Address `protobuf:"bytes,1,opt,name=msg_sender,json=msgSender,proto3" json:"msg_sender,omitempty"`
+	BlockNumber uint64   `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Timestamp   uint64   `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EpochIndex  uint64   `protobuf:"varint,4,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	InputIndex  uint64   `protobuf:"varint,5,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+}
+
+func (x *InputMetadata) Reset() {
+	*x = InputMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InputMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InputMetadata) ProtoMessage() {}
+
+func (x *InputMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InputMetadata.ProtoReflect.Descriptor instead.
+func (*InputMetadata) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InputMetadata) GetMsgSender() *Address {
+	if x != nil {
+		return x.MsgSender
+	}
+	return nil
+}
+
+func (x *InputMetadata) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *InputMetadata) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *InputMetadata) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+func (x *InputMetadata) GetInputIndex() uint64 {
+	if x != nil {
+		return x.InputIndex
+	}
+	return 0
+}
+
+type AdvanceStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId         string         `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ActiveEpochIndex  uint64         `protobuf:"varint,2,opt,name=active_epoch_index,json=activeEpochIndex,proto3" json:"active_epoch_index,omitempty"`
+	CurrentInputIndex uint64         `protobuf:"varint,3,opt,name=current_input_index,json=currentInputIndex,proto3" json:"current_input_index,omitempty"`
+	InputMetadata     *InputMetadata `protobuf:"bytes,4,opt,name=input_metadata,json=inputMetadata,proto3" json:"input_metadata,omitempty"`
+	InputPayload      []byte         `protobuf:"bytes,5,opt,name=input_payload,json=inputPayload,proto3" json:"input_payload,omitempty"`
+}
+
+func (x *AdvanceStateRequest) Reset() {
+	*x = AdvanceStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdvanceStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvanceStateRequest) ProtoMessage() {}
+
+func (x *AdvanceStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvanceStateRequest.ProtoReflect.Descriptor instead.
+func (*AdvanceStateRequest) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AdvanceStateRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AdvanceStateRequest) GetActiveEpochIndex() uint64 {
+	if x != nil {
+		return x.ActiveEpochIndex
+	}
+	return 0
+}
+
+func (x *AdvanceStateRequest) GetCurrentInputIndex() uint64 {
+	if x != nil {
+		return x.CurrentInputIndex
+	}
+	return 0
+}
+
+func (x *AdvanceStateRequest) GetInputMetadata() *InputMetadata {
+	if x != nil {
+		return x.InputMetadata
+	}
+	return nil
+}
+
+func (x *AdvanceStateRequest) GetInputPayload() []byte {
+	if x != nil {
+		return x.InputPayload
+	}
+	return nil
+}
+
+type GetStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId []string `protobuf:"bytes,1,rep,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *GetStatusResponse) Reset() {
+	*x = GetStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusResponse) ProtoMessage() {}
+
+func (x *GetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetStatusResponse) GetSessionId() []string {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+type GetSessionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *GetSessionStatusRequest) Reset() {
+	*x = GetSessionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionStatusRequest) ProtoMessage() {}
+
+func (x *GetSessionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetSessionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSessionStatusRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type TaintStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode    int32  `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *TaintStatus) Reset() {
+	*x = TaintStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaintStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaintStatus) ProtoMessage() {}
+
+func (x *TaintStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaintStatus.ProtoReflect.Descriptor instead.
+func (*TaintStatus) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TaintStatus) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *TaintStatus) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetSessionStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId        string       `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ActiveEpochIndex uint64       `protobuf:"varint,2,opt,name=active_epoch_index,json=activeEpochIndex,proto3" json:"active_epoch_index,omitempty"`
+	EpochIndex       []uint64     `protobuf:"varint,3,rep,packed,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	TaintStatus      *TaintStatus `protobuf:"bytes,4,opt,name=taint_status,json=taintStatus,proto3" json:"taint_status,omitempty"`
+}
+
+func (x *GetSessionStatusResponse) Reset() {
+	*x = GetSessionStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSessionStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSessionStatusResponse) ProtoMessage() {}
+
+func (x *GetSessionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSessionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetSessionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetSessionStatusResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetSessionStatusResponse) GetActiveEpochIndex() uint64 {
+	if x != nil {
+		return x.ActiveEpochIndex
+	}
+	return 0
+}
+
+func (x *GetSessionStatusResponse) GetEpochIndex() []uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return nil
+}
+
+func (x *GetSessionStatusResponse) GetTaintStatus() *TaintStatus {
+	if x != nil {
+		return x.TaintStatus
+	}
+	return nil
+}
+
+type GetEpochStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	EpochIndex uint64 `protobuf:"varint,2,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+}
+
+func (x *GetEpochStatusRequest) Reset() {
+	*x = GetEpochStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEpochStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochStatusRequest) ProtoMessage() {}
+
+func (x *GetEpochStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetEpochStatusRequest) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEpochStatusRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetEpochStatusRequest) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+type Voucher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keccak                *Hash            `protobuf:"bytes,1,opt,name=keccak,proto3" json:"keccak,omitempty"`
+	Destination           *Address         `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Payload               []byte           `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	KeccakInVoucherHashes *MerkleTreeProof `protobuf:"bytes,4,opt,name=keccak_in_voucher_hashes,json=keccakInVoucherHashes,proto3" json:"keccak_in_voucher_hashes,omitempty"`
+}
+
+func (x *Voucher) Reset() {
+	*x = Voucher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Voucher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Voucher) ProtoMessage() {}
+
+func (x *Voucher) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Voucher.ProtoReflect.Descriptor instead.
+func (*Voucher) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Voucher) GetKeccak() *Hash {
+	if x != nil {
+		return x.Keccak
+	}
+	return nil
+}
+
+func (x *Voucher) GetDestination() *Address {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *Voucher) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Voucher) GetKeccakInVoucherHashes() *MerkleTreeProof {
+	if x != nil {
+		return x.KeccakInVoucherHashes
+	}
+	return nil
+}
+
+type Notice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keccak               *Hash            `protobuf:"bytes,1,opt,name=keccak,proto3" json:"keccak,omitempty"`
+	Payload              []byte           `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	KeccakInNoticeHashes *MerkleTreeProof `protobuf:"bytes,3,opt,name=keccak_in_notice_hashes,json=keccakInNoticeHashes,proto3" json:"keccak_in_notice_hashes,omitempty"`
+}
+
+func (x *Notice) Reset() {
+	*x = Notice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notice) ProtoMessage() {}
+
+func (x *Notice) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notice.ProtoReflect.Descriptor instead.
+func (*Notice) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Notice) GetKeccak() *Hash {
+	if x != nil {
+		return x.Keccak
+	}
+	return nil
+}
+
+func (x *Notice) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Notice) GetKeccakInNoticeHashes() *MerkleTreeProof {
+	if x != nil {
+		return x.KeccakInNoticeHashes
+	}
+	return nil
+}
+
+type Report struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Report) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type AcceptedData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vouchers []*Voucher `protobuf:"bytes,1,rep,name=vouchers,proto3" json:"vouchers,omitempty"`
+	Notices  []*Notice  `protobuf:"bytes,2,rep,name=notices,proto3" json:"notices,omitempty"`
+}
+
+func (x *AcceptedData) Reset() {
+	*x = AcceptedData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptedData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptedData) ProtoMessage() {}
+
+func (x *AcceptedData) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptedData.ProtoReflect.Descriptor instead.
+func (*AcceptedData) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AcceptedData) GetVouchers() []*Voucher {
+	if x != nil {
+		return x.Vouchers
+	}
+	return nil
+}
+
+func (x *AcceptedData) GetNotices() []*Notice {
+	if x != nil {
+		return x.Notices
+	}
+	return nil
+}
+
+type ProcessedInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InputIndex            uint64           `protobuf:"varint,1,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+	MostRecentMachineHash *Hash            `protobuf:"bytes,2,opt,name=most_recent_machine_hash,json=mostRecentMachineHash,proto3" json:"most_recent_machine_hash,omitempty"`
+	VoucherHashesInEpoch  *MerkleTreeProof `protobuf:"bytes,3,opt,name=voucher_hashes_in_epoch,json=voucherHashesInEpoch,proto3" json:"voucher_hashes_in_epoch,omitempty"`
+	NoticeHashesInEpoch   *MerkleTreeProof `protobuf:"bytes,4,opt,name=notice_hashes_in_epoch,json=noticeHashesInEpoch,proto3" json:"notice_hashes_in_epoch,omitempty"`
+	Reports               []*Report        `protobuf:"bytes,5,rep,name=reports,proto3" json:"reports,omitempty"`
+	// Types that are assignable to ProcessedInputOneOf:
+	//
+	//	*ProcessedInput_AcceptedData
+	//	*ProcessedInput_ExceptionData
+	ProcessedInputOneOf isProcessedInput_ProcessedInputOneOf `protobuf_oneof:"ProcessedInputOneOf"`
+	Status              CompletionStatus                     `protobuf:"varint,8,opt,name=status,proto3,enum=CartesiServerManager.CompletionStatus" json:"status,omitempty"`
+}
+
+func (x *ProcessedInput) Reset() {
+	*x = ProcessedInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessedInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessedInput) ProtoMessage() {}
+
+func (x *ProcessedInput) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessedInput.ProtoReflect.Descriptor instead.
+func (*ProcessedInput) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ProcessedInput) GetInputIndex() uint64 {
+	if x != nil {
+		return x.InputIndex
+	}
+	return 0
+}
+
+func (x *ProcessedInput) GetMostRecentMachineHash() *Hash {
+	if x != nil {
+		return x.MostRecentMachineHash
+	}
+	return nil
+}
+
+func (x *ProcessedInput) GetVoucherHashesInEpoch() *MerkleTreeProof {
+	if x != nil {
+		return x.VoucherHashesInEpoch
+	}
+	return nil
+}
+
+func (x *ProcessedInput) GetNoticeHashesInEpoch() *MerkleTreeProof {
+	if x != nil {
+		return x.NoticeHashesInEpoch
+	}
+	return nil
+}
+
+func (x *ProcessedInput) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (m *ProcessedInput) GetProcessedInputOneOf() isProcessedInput_ProcessedInputOneOf {
+	if m != nil {
+		return m.ProcessedInputOneOf
+	}
+	return nil
+}
+
+func (x *ProcessedInput) GetAcceptedData() *AcceptedData {
+	if x, ok := x.GetProcessedInputOneOf().(*ProcessedInput_AcceptedData); ok {
+		return x.AcceptedData
+	}
+	return nil
+}
+
+func (x *ProcessedInput) GetExceptionData() []byte {
+	if x, ok := x.GetProcessedInputOneOf().(*ProcessedInput_ExceptionData); ok {
+		return x.ExceptionData
+	}
+	return nil
+}
+
+func (x *ProcessedInput) GetStatus() CompletionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CompletionStatus_ACCEPTED
+}
+
+type isProcessedInput_ProcessedInputOneOf interface {
+	isProcessedInput_ProcessedInputOneOf()
+}
+
+type ProcessedInput_AcceptedData struct {
+	AcceptedData *AcceptedData `protobuf:"bytes,6,opt,name=accepted_data,json=acceptedData,proto3,oneof"`
+}
+
+type ProcessedInput_ExceptionData struct {
+	ExceptionData []byte `protobuf:"bytes,7,opt,name=exception_data,json=exceptionData,proto3,oneof"`
+}
+
+func (*ProcessedInput_AcceptedData) isProcessedInput_ProcessedInputOneOf() {}
+
+func (*ProcessedInput_ExceptionData) isProcessedInput_ProcessedInputOneOf() {}
+
+type GetEpochStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId                       string            `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	EpochIndex                      uint64            `protobuf:"varint,2,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+	State                           EpochState        `protobuf:"varint,3,opt,name=state,proto3,enum=CartesiServerManager.EpochState" json:"state,omitempty"`
+	MostRecentMachineHash           *Hash             `protobuf:"bytes,4,opt,name=most_recent_machine_hash,json=mostRecentMachineHash,proto3" json:"most_recent_machine_hash,omitempty"`
+	MostRecentVouchersEpochRootHash *Hash             `protobuf:"bytes,5,opt,name=most_recent_vouchers_epoch_root_hash,json=mostRecentVouchersEpochRootHash,proto3" json:"most_recent_vouchers_epoch_root_hash,omitempty"`
+	MostRecentNoticesEpochRootHash  *Hash             `protobuf:"bytes,6,opt,name=most_recent_notices_epoch_root_hash,json=mostRecentNoticesEpochRootHash,proto3" json:"most_recent_notices_epoch_root_hash,omitempty"`
+	ProcessedInputs                 []*ProcessedInput `protobuf:"bytes,7,rep,name=processed_inputs,json=processedInputs,proto3" json:"processed_inputs,omitempty"`
+	PendingInputCount               uint64            `protobuf:"varint,8,opt,name=pending_input_count,json=pendingInputCount,proto3" json:"pending_input_count,omitempty"`
+	TaintStatus                     *TaintStatus      `protobuf:"bytes,9,opt,name=taint_status,json=taintStatus,proto3" json:"taint_status,omitempty"`
+}
+
+func (x *GetEpochStatusResponse) Reset() {
+	*x = GetEpochStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEpochStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpochStatusResponse) ProtoMessage() {}
+
+func (x *GetEpochStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpochStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetEpochStatusResponse) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetEpochStatusResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetEpochStatusResponse) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+func (x *GetEpochStatusResponse) GetState() EpochState {
+	if x != nil {
+		return x.State
+	}
+	return EpochState_ACTIVE
+}
+
+func (x *GetEpochStatusResponse) GetMostRecentMachineHash() *Hash {
+	if x != nil {
+		return x.MostRecentMachineHash
+	}
+	return nil
+}
+
+func (x *GetEpochStatusResponse) GetMostRecentVouchersEpochRootHash() *Hash {
+	if x != nil {
+		return x.MostRecentVouchersEpochRootHash
+	}
+	return nil
+}
+
+func (x *GetEpochStatusResponse) GetMostRecentNoticesEpochRootHash() *Hash {
+	if x != nil {
+		return x.MostRecentNoticesEpochRootHash
+	}
+	return nil
+}
+
+func (x *GetEpochStatusResponse) GetProcessedInputs() []*ProcessedInput {
+	if x != nil {
+		return x.ProcessedInputs
+	}
+	return nil
+}
+
+func (x *GetEpochStatusResponse) GetPendingInputCount() uint64 {
+	if x != nil {
+		return x.PendingInputCount
+	}
+	return 0
+}
+
+func (x *GetEpochStatusResponse) GetTaintStatus() *TaintStatus {
+	if x != nil {
+		return x.TaintStatus
+	}
+	return nil
+}
+
+type InspectStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId    string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	QueryPayload []byte `protobuf:"bytes,2,opt,name=query_payload,json=queryPayload,proto3" json:"query_payload,omitempty"`
+}
+
+func (x *InspectStateRequest) Reset() {
+	*x = InspectStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InspectStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectStateRequest) ProtoMessage() {}
+
+func (x *InspectStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectStateRequest.ProtoReflect.Descriptor instead.
+func (*InspectStateRequest) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *InspectStateRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *InspectStateRequest) GetQueryPayload() []byte {
+	if x != nil {
+		return x.QueryPayload
+	}
+	return nil
+}
+
+type InspectStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId           string           `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ActiveEpochIndex    uint64           `protobuf:"varint,2,opt,name=active_epoch_index,json=activeEpochIndex,proto3" json:"active_epoch_index,omitempty"`
+	ProcessedInputCount uint64           `protobuf:"varint,3,opt,name=processed_input_count,json=processedInputCount,proto3" json:"processed_input_count,omitempty"`
+	Status              CompletionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=CartesiServerManager.CompletionStatus" json:"status,omitempty"`
+	ExceptionData       []byte           `protobuf:"bytes,5,opt,name=exception_data,json=exceptionData,proto3,oneof" json:"exception_data,omitempty"`
+	Reports             []*Report        `protobuf:"bytes,6,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (x *InspectStateResponse) Reset() {
+	*x = InspectStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InspectStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InspectStateResponse) ProtoMessage() {}
+
+func (x *InspectStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InspectStateResponse.ProtoReflect.Descriptor instead.
+func (*InspectStateResponse) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InspectStateResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *InspectStateResponse) GetActiveEpochIndex() uint64 {
+	if x != nil {
+		return x.ActiveEpochIndex
+	}
+	return 0
+}
+
+func (x *InspectStateResponse) GetProcessedInputCount() uint64 {
+	if x != nil {
+		return x.ProcessedInputCount
+	}
+	return 0
+}
+
+func (x *InspectStateResponse) GetStatus() CompletionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CompletionStatus_ACCEPTED
+}
+
+func (x *InspectStateResponse) GetExceptionData() []byte {
+	if x != nil {
+		return x.ExceptionData
+	}
+	return nil
+}
+
+func (x *InspectStateResponse) GetReports() []*Report {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type FinishEpochRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId           string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ActiveEpochIndex    uint64 `protobuf:"varint,2,opt,name=active_epoch_index,json=activeEpochIndex,proto3" json:"active_epoch_index,omitempty"`
+	ProcessedInputCount uint64 `protobuf:"varint,3,opt,name=processed_input_count,json=processedInputCount,proto3" json:"processed_input_count,omitempty"`
+	StorageDirectory    string `protobuf:"bytes,4,opt,name=storage_directory,json=storageDirectory,proto3" json:"storage_directory,omitempty"`
+}
+
+func (x *FinishEpochRequest) Reset() {
+	*x = FinishEpochRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinishEpochRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishEpochRequest) ProtoMessage() {}
+
+func (x *FinishEpochRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishEpochRequest.ProtoReflect.Descriptor instead.
+func (*FinishEpochRequest) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FinishEpochRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *FinishEpochRequest) GetActiveEpochIndex() uint64 {
+	if x != nil {
+		return x.ActiveEpochIndex
+	}
+	return 0
+}
+
+func (x *FinishEpochRequest) GetProcessedInputCount() uint64 {
+	if x != nil {
+		return x.ProcessedInputCount
+	}
+	return 0
+}
+
+func (x *FinishEpochRequest) GetStorageDirectory() string {
+	if x != nil {
+		return x.StorageDirectory
+	}
+	return ""
+}
+
+type FinishEpochResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MachineHash           *Hash `protobuf:"bytes,1,opt,name=machine_hash,json=machineHash,proto3" json:"machine_hash,omitempty"`
+	VouchersEpochRootHash *Hash `protobuf:"bytes,2,opt,name=vouchers_epoch_root_hash,json=vouchersEpochRootHash,proto3" json:"vouchers_epoch_root_hash,omitempty"`
+	NoticesEpochRootHash  *Hash `protobuf:"bytes,3,opt,name=notices_epoch_root_hash,json=noticesEpochRootHash,proto3" json:"notices_epoch_root_hash,omitempty"`
+}
+
+func (x *FinishEpochResponse) Reset() {
+	*x = FinishEpochResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinishEpochResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishEpochResponse) ProtoMessage() {}
+
+func (x *FinishEpochResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishEpochResponse.ProtoReflect.Descriptor instead.
+func (*FinishEpochResponse) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FinishEpochResponse) GetMachineHash() *Hash {
+	if x != nil {
+		return x.MachineHash
+	}
+	return nil
+}
+
+func (x *FinishEpochResponse) GetVouchersEpochRootHash() *Hash {
+	if x != nil {
+		return x.VouchersEpochRootHash
+	}
+	return nil
+}
+
+func (x *FinishEpochResponse) GetNoticesEpochRootHash() *Hash {
+	if x != nil {
+		return x.NoticesEpochRootHash
+	}
+	return nil
+}
+
+type DeleteEpochRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	EpochIndex uint64 `protobuf:"varint,2,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
+}
+
+func (x *DeleteEpochRequest) Reset() {
+	*x = DeleteEpochRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_manager_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEpochRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEpochRequest) ProtoMessage() {}
+
+func (x *DeleteEpochRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_manager_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEpochRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEpochRequest) Descriptor() ([]byte, []int) {
+	return file_server_manager_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteEpochRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DeleteEpochRequest) GetEpochIndex() uint64 {
+	if x != nil {
+		return x.EpochIndex
+	}
+	return 0
+}
+
+var File_server_manager_proto protoreflect.FileDescriptor
+
+var file_server_manager_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2d, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x1a, 0x10, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15,
+	0x63, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x2d, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd6, 0x01, 0x0a, 0x0c, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x64,
+	0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
+	0x78, 0x5f, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa8,
+	0x02, 0x0a, 0x0e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x36, 0x0a, 0x17, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a,
+	0x17, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15,
+	0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x61, 0x73, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x61, 0x73, 0x74, 0x22, 0x9b, 0x03, 0x0a, 0x13, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x0a,
+	0x12, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x32, 0x0a, 0x15, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x47, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x79,
+	0x63, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65,
+	0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x32, 0x0a, 0x11, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x1d, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xd0, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x0a, 0x6d, 0x73, 0x67, 0x5f, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65,
+	0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x6d, 0x73, 0x67, 0x53, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x83, 0x02, 0x0a, 0x13, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4a, 0x0a, 0x0e, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x32, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x38, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x0b, 0x54, 0x61, 0x69,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xce, 0x01, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x44, 0x0a, 0x0c, 0x74, 0x61, 0x69, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0b, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x57, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xec, 0x01, 0x0a, 0x07, 0x56, 0x6f, 0x75, 0x63, 0x68,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x6b, 0x65, 0x63, 0x63, 0x61, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x6b, 0x65, 0x63, 0x63, 0x61, 0x6b,
+	0x12, 0x3f, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x58, 0x0a, 0x18, 0x6b,
+	0x65, 0x63, 0x63, 0x61, 0x6b, 0x5f, 0x69, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d,
+	0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x15,
+	0x6b, 0x65, 0x63, 0x63, 0x61, 0x6b, 0x49, 0x6e, 0x56, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65,
+	0x12, 0x2c, 0x0a, 0x06, 0x6b, 0x65, 0x63, 0x63, 0x61, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x06, 0x6b, 0x65, 0x63, 0x63, 0x61, 0x6b, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x56, 0x0a, 0x17, 0x6b, 0x65, 0x63, 0x63,
+	0x61, 0x6b, 0x5f, 0x69, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x43, 0x61, 0x72, 0x74,
+	0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c,
+	0x65, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x14, 0x6b, 0x65, 0x63, 0x63,
+	0x61, 0x6b, 0x49, 0x6e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x22, 0x22, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x08, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73,
+	0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x56,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x52, 0x08, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73,
+	0x12, 0x36, 0x0a, 0x07, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x52,
+	0x07, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x22, 0xb1, 0x04, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x4d, 0x0a, 0x18,
+	0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x63, 0x68,
+	0x69, 0x6e, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x48, 0x61, 0x73, 0x68, 0x52, 0x15, 0x6d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74,
+	0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x56, 0x0a, 0x17, 0x76,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x5f, 0x69, 0x6e,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x43,
+	0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65,
+	0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x14, 0x76,
+	0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x49, 0x6e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x54, 0x0a, 0x16, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x54, 0x72, 0x65, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x13, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x48, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x49, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x43, 0x61, 0x72,
+	0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x49, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65,
+	0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0c,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0e,
+	0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x15, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x22, 0xee, 0x04, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x4d, 0x0a, 0x18, 0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x15, 0x6d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63,
+	0x65, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x63,
+	0x0a, 0x24, 0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x6f,
+	0x75, 0x63, 0x68, 0x65, 0x72, 0x73, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43,
+	0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x48, 0x61,
+	0x73, 0x68, 0x52, 0x1f, 0x6d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x56, 0x6f,
+	0x75, 0x63, 0x68, 0x65, 0x72, 0x73, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x74, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x61, 0x0a, 0x23, 0x6d, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65,
+	0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x1e, 0x6d, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65,
+	0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x6f,
+	0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x4f, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0c, 0x74, 0x61, 0x69, 0x6e, 0x74,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x0b, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x59, 0x0a,
+	0x13, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xce, 0x02, 0x0a, 0x14, 0x49, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x2c, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x32,
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x78,
+	0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x36,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc2, 0x01, 0x0a, 0x12, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x32, 0x0a,
+	0x15, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xea,
+	0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x43,
+	0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x48, 0x61,
+	0x73, 0x68, 0x52, 0x0b, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x4d, 0x0a, 0x18, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72, 0x73, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69,
+	0x6e, 0x65, 0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x15, 0x76, 0x6f, 0x75, 0x63, 0x68, 0x65, 0x72,
+	0x73, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x4b,
+	0x0a, 0x17, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x2e, 0x48, 0x61, 0x73, 0x68, 0x52, 0x14, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x73, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x54, 0x0a, 0x12, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x2a, 0x26, 0x0a, 0x0a, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46,
+	0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x01, 0x2a, 0xa7, 0x01, 0x0a, 0x10, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0c,
+	0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x45, 0x58,
+	0x43, 0x45, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x41, 0x43,
+	0x48, 0x49, 0x4e, 0x45, 0x5f, 0x48, 0x41, 0x4c, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x18, 0x0a,
+	0x14, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43,
+	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x49, 0x4d, 0x45, 0x5f,
+	0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x05,
+	0x12, 0x21, 0x0a, 0x1d, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x4c, 0x45, 0x4e, 0x47,
+	0x54, 0x48, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45,
+	0x44, 0x10, 0x06, 0x32, 0xb2, 0x07, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x1e, 0x2e, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0c, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x43, 0x61,
+	0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0a, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x43, 0x61,
+	0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x76, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x27, 0x2e, 0x43, 0x61, 0x72, 0x74,
+	0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65,
+	0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73,
+	0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x43, 0x61,
+	0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65,
+	0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0c, 0x49, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65,
+	0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x64, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x28, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x43, 0x61, 0x72,
+	0x74, 0x65, 0x73, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x28, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x65, 0x73, 0x69, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_server_manager_proto_rawDescOnce sync.Once
+	file_server_manager_proto_rawDescData = file_server_manager_proto_rawDesc
+)
+
+func file_server_manager_proto_rawDescGZIP() []byte {
+	file_server_manager_proto_rawDescOnce.Do(func() {
+		file_server_manager_proto_rawDescData = protoimpl.X.CompressGZIP(file_server_manager_proto_rawDescData)
+	})
+	return file_server_manager_proto_rawDescData
+}
+
+var file_server_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_server_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_server_manager_proto_goTypes = []interface{}{
+	(EpochState)(0),                  // 0: CartesiServerManager.EpochState
+	(CompletionStatus)(0),            // 1: CartesiServerManager.CompletionStatus
+	(*CyclesConfig)(nil),             // 2: CartesiServerManager.CyclesConfig
+	(*DeadlineConfig)(nil),           // 3: CartesiServerManager.DeadlineConfig
+	(*StartSessionRequest)(nil),      // 4: CartesiServerManager.StartSessionRequest
+	(*StartSessionResponse)(nil),     // 5: CartesiServerManager.StartSessionResponse
+	(*EndSessionRequest)(nil),        // 6: CartesiServerManager.EndSessionRequest
+	(*Address)(nil),                  // 7: CartesiServerManager.Address
+	(*InputMetadata)(nil),            // 8: CartesiServerManager.InputMetadata
+	(*AdvanceStateRequest)(nil),      // 9: CartesiServerManager.AdvanceStateRequest
+	(*GetStatusResponse)(nil),        // 10: CartesiServerManager.GetStatusResponse
+	(*GetSessionStatusRequest)(nil),  // 11: CartesiServerManager.GetSessionStatusRequest
+	(*TaintStatus)(nil),              // 12: CartesiServerManager.TaintStatus
+	(*GetSessionStatusResponse)(nil), // 13: CartesiServerManager.GetSessionStatusResponse
+	(*GetEpochStatusRequest)(nil),    // 14: CartesiServerManager.GetEpochStatusRequest
+	(*Voucher)(nil),                  // 15: CartesiServerManager.Voucher
+	(*Notice)(nil),                   // 16: CartesiServerManager.Notice
+	(*Report)(nil),                   // 17: CartesiServerManager.Report
+	(*AcceptedData)(nil),             // 18: CartesiServerManager.AcceptedData
+	(*ProcessedInput)(nil),           // 19: CartesiServerManager.ProcessedInput
+	(*GetEpochStatusResponse)(nil),   // 20: CartesiServerManager.GetEpochStatusResponse
+	(*InspectStateRequest)(nil),      // 21: CartesiServerManager.InspectStateRequest
+	(*InspectStateResponse)(nil),     // 22: CartesiServerManager.InspectStateResponse
+	(*FinishEpochRequest)(nil),       // 23: CartesiServerManager.FinishEpochRequest
+	(*FinishEpochResponse)(nil),      // 24: CartesiServerManager.FinishEpochResponse
+	(*DeleteEpochRequest)(nil),       // 25: CartesiServerManager.DeleteEpochRequest
+	(*MachineRuntimeConfig)(nil),     // 26: CartesiMachine.MachineRuntimeConfig
+	(*Hash)(nil),                     // 27: CartesiMachine.Hash
+	(*MerkleTreeProof)(nil),          // 28: CartesiMachine.MerkleTreeProof
+	(*Void)(nil),                     // 29: CartesiMachine.Void
+	(*GetVersionResponse)(nil),       // 30: Versioning.GetVersionResponse
+}
+var file_server_manager_proto_depIdxs = []int32{
+	2,  // 0: CartesiServerManager.StartSessionRequest.server_cycles:type_name -> CartesiServerManager.CyclesConfig
+	3,  // 1: CartesiServerManager.StartSessionRequest.server_deadline:type_name -> CartesiServerManager.DeadlineConfig
+	26, // 2: CartesiServerManager.StartSessionRequest.runtime:type_name -> CartesiMachine.MachineRuntimeConfig
+	7,  // 3: CartesiServerManager.InputMetadata.msg_sender:type_name -> CartesiServerManager.Address
+	8,  // 4: CartesiServerManager.AdvanceStateRequest.input_metadata:type_name -> CartesiServerManager.InputMetadata
+	12, // 5: CartesiServerManager.GetSessionStatusResponse.taint_status:type_name -> CartesiServerManager.TaintStatus
+	27, // 6: CartesiServerManager.Voucher.keccak:type_name -> CartesiMachine.Hash
+	7,  // 7: CartesiServerManager.Voucher.destination:type_name -> CartesiServerManager.Address
+	28, // 8: CartesiServerManager.Voucher.keccak_in_voucher_hashes:type_name -> CartesiMachine.MerkleTreeProof
+	27, // 9: CartesiServerManager.Notice.keccak:type_name -> CartesiMachine.Hash
+	28, // 10: CartesiServerManager.Notice.keccak_in_notice_hashes:type_name -> CartesiMachine.MerkleTreeProof
+	15, // 11: CartesiServerManager.AcceptedData.vouchers:type_name -> CartesiServerManager.Voucher
+	16, // 12: CartesiServerManager.AcceptedData.notices:type_name -> CartesiServerManager.Notice
+	27, // 13: CartesiServerManager.ProcessedInput.most_recent_machine_hash:type_name -> CartesiMachine.Hash
+	28, // 14: CartesiServerManager.ProcessedInput.voucher_hashes_in_epoch:type_name -> CartesiMachine.MerkleTreeProof
+	28, // 15: CartesiServerManager.ProcessedInput.notice_hashes_in_epoch:type_name -> CartesiMachine.MerkleTreeProof
+	17, // 16: CartesiServerManager.ProcessedInput.reports:type_name -> CartesiServerManager.Report
+	18, // 17: CartesiServerManager.ProcessedInput.accepted_data:type_name -> CartesiServerManager.AcceptedData
+	1,  // 18: CartesiServerManager.ProcessedInput.status:type_name -> CartesiServerManager.CompletionStatus
+	0,  // 19: CartesiServerManager.GetEpochStatusResponse.state:type_name -> CartesiServerManager.EpochState
+	27, // 20: CartesiServerManager.GetEpochStatusResponse.most_recent_machine_hash:type_name -> CartesiMachine.Hash
+	27, // 21: CartesiServerManager.GetEpochStatusResponse.most_recent_vouchers_epoch_root_hash:type_name -> CartesiMachine.Hash
+	27, // 22: CartesiServerManager.GetEpochStatusResponse.most_recent_notices_epoch_root_hash:type_name -> CartesiMachine.Hash
+	19, // 23: CartesiServerManager.GetEpochStatusResponse.processed_inputs:type_name -> CartesiServerManager.ProcessedInput
+	12, // 24: CartesiServerManager.GetEpochStatusResponse.taint_status:type_name -> CartesiServerManager.TaintStatus
+	1,  // 25: CartesiServerManager.InspectStateResponse.status:type_name -> CartesiServerManager.CompletionStatus
+	17, // 26: CartesiServerManager.InspectStateResponse.reports:type_name -> CartesiServerManager.Report
+	27, // 27: CartesiServerManager.FinishEpochResponse.machine_hash:type_name -> CartesiMachine.Hash
+	27, // 28: CartesiServerManager.FinishEpochResponse.vouchers_epoch_root_hash:type_name -> CartesiMachine.Hash
+	27, // 29: CartesiServerManager.FinishEpochResponse.notices_epoch_root_hash:type_name -> CartesiMachine.Hash
+	29, // 30: CartesiServerManager.ServerManager.GetVersion:input_type -> CartesiMachine.Void
+	4,  // 31: CartesiServerManager.ServerManager.StartSession:input_type -> CartesiServerManager.StartSessionRequest
+	6,  // 32: CartesiServerManager.ServerManager.EndSession:input_type -> CartesiServerManager.EndSessionRequest
+	9,  // 33: CartesiServerManager.ServerManager.AdvanceState:input_type -> CartesiServerManager.AdvanceStateRequest
+	29, // 34: CartesiServerManager.ServerManager.GetStatus:input_type -> CartesiMachine.Void
+	11, // 35: CartesiServerManager.ServerManager.GetSessionStatus:input_type -> CartesiServerManager.GetSessionStatusRequest
+	14, // 36: CartesiServerManager.ServerManager.GetEpochStatus:input_type -> CartesiServerManager.GetEpochStatusRequest
+	21, // 37: CartesiServerManager.ServerManager.InspectState:input_type -> CartesiServerManager.InspectStateRequest
+	23, // 38: CartesiServerManager.ServerManager.FinishEpoch:input_type -> CartesiServerManager.FinishEpochRequest
+	25, // 39: CartesiServerManager.ServerManager.DeleteEpoch:input_type -> CartesiServerManager.DeleteEpochRequest
+	30, // 40: CartesiServerManager.ServerManager.GetVersion:output_type -> Versioning.GetVersionResponse
+	5,  // 41: CartesiServerManager.ServerManager.StartSession:output_type -> CartesiServerManager.StartSessionResponse
+	29, // 42: CartesiServerManager.ServerManager.EndSession:output_type -> CartesiMachine.Void
+	29, // 43: CartesiServerManager.ServerManager.AdvanceState:output_type -> CartesiMachine.Void
+	10, // 44: CartesiServerManager.ServerManager.GetStatus:output_type -> CartesiServerManager.GetStatusResponse
+	13, // 45: CartesiServerManager.ServerManager.GetSessionStatus:output_type -> CartesiServerManager.GetSessionStatusResponse
+	20, // 46: CartesiServerManager.ServerManager.GetEpochStatus:output_type -> CartesiServerManager.GetEpochStatusResponse
+	22, // 47: CartesiServerManager.ServerManager.InspectState:output_type -> CartesiServerManager.InspectStateResponse
+	24, // 48: CartesiServerManager.ServerManager.FinishEpoch:output_type -> CartesiServerManager.FinishEpochResponse
+	29, // 49: CartesiServerManager.ServerManager.DeleteEpoch:output_type -> CartesiMachine.Void
+	40, // [40:50] is the sub-list for method output_type
+	30, // [30:40] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
+}
+
+func init() { file_server_manager_proto_init() }
+func file_server_manager_proto_init() {
+	if File_server_manager_proto != nil {
+		return
+	}
+	file_versioning_proto_init()
+	file_cartesi_machine_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_server_manager_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CyclesConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadlineConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EndSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InputMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdvanceStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaintStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSessionStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEpochStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Voucher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Report); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptedData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessedInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEpochStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InspectStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishEpochRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishEpochResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_manager_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEpochRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_server_manager_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*ProcessedInput_AcceptedData)(nil),
+		(*ProcessedInput_ExceptionData)(nil),
+	}
+	file_server_manager_proto_msgTypes[20].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_server_manager_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_server_manager_proto_goTypes,
+		DependencyIndexes: file_server_manager_proto_depIdxs,
+		EnumInfos:         file_server_manager_proto_enumTypes,
+		MessageInfos:      file_server_manager_proto_msgTypes,
+	}.Build()
+	File_server_manager_proto = out.File
+	file_server_manager_proto_rawDesc = nil
+	file_server_manager_proto_goTypes = nil
+	file_server_manager_proto_depIdxs = nil
+}
@@ -0,0 +1,447 @@
+// Subset of server-manager.proto from github.com/cartesi/grpc-interfaces, which nonodo uses to
+// generate the server-manager client in internal/servermanager/pb.
+// The messages keep the upstream field numbers; the epoch proofs, which nonodo doesn't use, and
+// the machine config returned by StartSession are omitted, so the client ignores them.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: server-manager.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ServerManager_GetVersion_FullMethodName       = "/CartesiServerManager.ServerManager/GetVersion"
+	ServerManager_StartSession_FullMethodName     = "/CartesiServerManager.ServerManager/StartSession"
+	ServerManager_EndSession_FullMethodName       = "/CartesiServerManager.ServerManager/EndSession"
+	ServerManager_AdvanceState_FullMethodName     = "/CartesiServerManager.ServerManager/AdvanceState"
+	ServerManager_GetStatus_FullMethodName        = "/CartesiServerManager.ServerManager/GetStatus"
+	ServerManager_GetSessionStatus_FullMethodName = "/CartesiServerManager.ServerManager/GetSessionStatus"
+	ServerManager_GetEpochStatus_FullMethodName   = "/CartesiServerManager.ServerManager/GetEpochStatus"
+	ServerManager_InspectState_FullMethodName     = "/CartesiServerManager.ServerManager/InspectState"
+	ServerManager_FinishEpoch_FullMethodName      = "/CartesiServerManager.ServerManager/FinishEpoch"
+	ServerManager_DeleteEpoch_FullMethodName      = "/CartesiServerManager.ServerManager/DeleteEpoch"
+)
+
+// ServerManagerClient is the client API for ServerManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServerManagerClient interface {
+	GetVersion(ctx context.Context, in *Void, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error)
+	EndSession(ctx context.Context, in *EndSessionRequest, opts ...grpc.CallOption) (*Void, error)
+	AdvanceState(ctx context.Context, in *AdvanceStateRequest, opts ...grpc.CallOption) (*Void, error)
+	GetStatus(ctx context.Context, in *Void, opts ...grpc.CallOption) (*GetStatusResponse, error)
+	GetSessionStatus(ctx context.Context, in *GetSessionStatusRequest, opts ...grpc.CallOption) (*GetSessionStatusResponse, error)
+	GetEpochStatus(ctx context.Context, in *GetEpochStatusRequest, opts ...grpc.CallOption) (*GetEpochStatusResponse, error)
+	InspectState(ctx context.Context, in *InspectStateRequest, opts ...grpc.CallOption) (*InspectStateResponse, error)
+	FinishEpoch(ctx context.Context, in *FinishEpochRequest, opts ...grpc.CallOption) (*FinishEpochResponse, error)
+	DeleteEpoch(ctx context.Context, in *DeleteEpochRequest, opts ...grpc.CallOption) (*Void, error)
+}
+
+type serverManagerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServerManagerClient(cc grpc.ClientConnInterface) ServerManagerClient {
+	return &serverManagerClient{cc}
+}
+
+func (c *serverManagerClient) GetVersion(ctx context.Context, in *Void, opts ...grpc.CallOption) (*GetVersionResponse, error) {
+	out := new(GetVersionResponse)
+	err := c.cc.Invoke(ctx, ServerManager_GetVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverManagerClient) StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error) {
+	out := new(StartSessionResponse)
+	err := c.cc.Invoke(ctx, ServerManager_StartSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverManagerClient) EndSession(ctx context.Context, in *EndSessionRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, ServerManager_EndSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverManagerClient) AdvanceState(ctx context.Context, in *AdvanceStateRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, ServerManager_AdvanceState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverManagerClient) GetStatus(ctx context.Context, in *Void, opts ...grpc.CallOption) (*GetStatusResponse, error) {
+	out := new(GetStatusResponse)
+	err := c.cc.Invoke(ctx, ServerManager_GetStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverManagerClient) GetSessionStatus(ctx context.Context, in *GetSessionStatusRequest, opts ...grpc.CallOption) (*GetSessionStatusResponse, error) {
+	out := new(GetSessionStatusResponse)
+	err := c.cc.Invoke(ctx, ServerManager_GetSessionStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverManagerClient) GetEpochStatus(ctx context.Context, in *GetEpochStatusRequest, opts ...grpc.CallOption) (*GetEpochStatusResponse, error) {
+	out := new(GetEpochStatusResponse)
+	err := c.cc.Invoke(ctx, ServerManager_GetEpochStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverManagerClient) InspectState(ctx context.Context, in *InspectStateRequest, opts ...grpc.CallOption) (*InspectStateResponse, error) {
+	out := new(InspectStateResponse)
+	err := c.cc.Invoke(ctx, ServerManager_InspectState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverManagerClient) FinishEpoch(ctx context.Context, in *FinishEpochRequest, opts ...grpc.CallOption) (*FinishEpochResponse, error) {
+	out := new(FinishEpochResponse)
+	err := c.cc.Invoke(ctx, ServerManager_FinishEpoch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serverManagerClient) DeleteEpoch(ctx context.Context, in *DeleteEpochRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, ServerManager_DeleteEpoch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServerManagerServer is the server API for ServerManager service.
+// All implementations must embed UnimplementedServerManagerServer
+// for forward compatibility
+type ServerManagerServer interface {
+	GetVersion(context.Context, *Void) (*GetVersionResponse, error)
+	StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error)
+	EndSession(context.Context, *EndSessionRequest) (*Void, error)
+	AdvanceState(context.Context, *AdvanceStateRequest) (*Void, error)
+	GetStatus(context.Context, *Void) (*GetStatusResponse, error)
+	GetSessionStatus(context.Context, *GetSessionStatusRequest) (*GetSessionStatusResponse, error)
+	GetEpochStatus(context.Context, *GetEpochStatusRequest) (*GetEpochStatusResponse, error)
+	InspectState(context.Context, *InspectStateRequest) (*InspectStateResponse, error)
+	FinishEpoch(context.Context, *FinishEpochRequest) (*FinishEpochResponse, error)
+	DeleteEpoch(context.Context, *DeleteEpochRequest) (*Void, error)
+	mustEmbedUnimplementedServerManagerServer()
+}
+
+// UnimplementedServerManagerServer must be embedded to have forward compatible implementations.
+type UnimplementedServerManagerServer struct {
+}
+
+func (UnimplementedServerManagerServer) GetVersion(context.Context, *Void) (*GetVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (UnimplementedServerManagerServer) StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSession not implemented")
+}
+func (UnimplementedServerManagerServer) EndSession(context.Context, *EndSessionRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndSession not implemented")
+}
+func (UnimplementedServerManagerServer) AdvanceState(context.Context, *AdvanceStateRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdvanceState not implemented")
+}
+func (UnimplementedServerManagerServer) GetStatus(context.Context, *Void) (*GetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedServerManagerServer) GetSessionStatus(context.Context, *GetSessionStatusRequest) (*GetSessionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSessionStatus not implemented")
+}
+func (UnimplementedServerManagerServer) GetEpochStatus(context.Context, *GetEpochStatusRequest) (*GetEpochStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpochStatus not implemented")
+}
+func (UnimplementedServerManagerServer) InspectState(context.Context, *InspectStateRequest) (*InspectStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectState not implemented")
+}
+func (UnimplementedServerManagerServer) FinishEpoch(context.Context, *FinishEpochRequest) (*FinishEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishEpoch not implemented")
+}
+func (UnimplementedServerManagerServer) DeleteEpoch(context.Context, *DeleteEpochRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEpoch not implemented")
+}
+func (UnimplementedServerManagerServer) mustEmbedUnimplementedServerManagerServer() {}
+
+// UnsafeServerManagerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServerManagerServer will
+// result in compilation errors.
+type UnsafeServerManagerServer interface {
+	mustEmbedUnimplementedServerManagerServer()
+}
+
+func RegisterServerManagerServer(s grpc.ServiceRegistrar, srv ServerManagerServer) {
+	s.RegisterService(&ServerManager_ServiceDesc, srv)
+}
+
+func _ServerManager_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerManagerServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerManager_GetVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerManagerServer).GetVersion(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerManager_StartSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerManagerServer).StartSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerManager_StartSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerManagerServer).StartSession(ctx, req.(*StartSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerManager_EndSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerManagerServer).EndSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerManager_EndSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerManagerServer).EndSession(ctx, req.(*EndSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerManager_AdvanceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdvanceStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerManagerServer).AdvanceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerManager_AdvanceState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerManagerServer).AdvanceState(ctx, req.(*AdvanceStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerManager_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerManagerServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerManager_GetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerManagerServer).GetStatus(ctx, req.(*Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerManager_GetSessionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerManagerServer).GetSessionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerManager_GetSessionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerManagerServer).GetSessionStatus(ctx, req.(*GetSessionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerManager_GetEpochStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpochStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerManagerServer).GetEpochStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerManager_GetEpochStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerManagerServer).GetEpochStatus(ctx, req.(*GetEpochStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerManager_InspectState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerManagerServer).InspectState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerManager_InspectState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerManagerServer).InspectState(ctx, req.(*InspectStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerManager_FinishEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerManagerServer).FinishEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerManager_FinishEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerManagerServer).FinishEpoch(ctx, req.(*FinishEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServerManager_DeleteEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServerManagerServer).DeleteEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServerManager_DeleteEpoch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServerManagerServer).DeleteEpoch(ctx, req.(*DeleteEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServerManager_ServiceDesc is the grpc.ServiceDesc for ServerManager service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServerManager_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "CartesiServerManager.ServerManager",
+	HandlerType: (*ServerManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVersion",
+			Handler:    _ServerManager_GetVersion_Handler,
+		},
+		{
+			MethodName: "StartSession",
+			Handler:    _ServerManager_StartSession_Handler,
+		},
+		{
+			MethodName: "EndSession",
+			Handler:    _ServerManager_EndSession_Handler,
+		},
+		{
+			MethodName: "AdvanceState",
+			Handler:    _ServerManager_AdvanceState_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _ServerManager_GetStatus_Handler,
+		},
+		{
+			MethodName: "GetSessionStatus",
+			Handler:    _ServerManager_GetSessionStatus_Handler,
+		},
+		{
+			MethodName: "GetEpochStatus",
+			Handler:    _ServerManager_GetEpochStatus_Handler,
+		},
+		{
+			MethodName: "InspectState",
+			Handler:    _ServerManager_InspectState_Handler,
+		},
+		{
+			MethodName: "FinishEpoch",
+			Handler:    _ServerManager_FinishEpoch_Handler,
+		},
+		{
+			MethodName: "DeleteEpoch",
+			Handler:    _ServerManager_DeleteEpoch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server-manager.proto",
+}
@@ -0,0 +1,133 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+// This pkg processes the inputs in a Cartesi machine through the server-manager.
+// Instead of serving the rollup API to an application in the host, nonodo forwards the inputs
+// to the server-manager and stores the outputs in the model.
+package servermanager
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+)
+
+// Interval between checks for new inputs when the model is idle.
+const PollInterval = 100 * time.Millisecond
+
+// Voucher emitted by the machine.
+type Voucher struct {
+	Destination common.Address
+	Payload     []byte
+}
+
+// Result of processing an input in the machine.
+type Result struct {
+	Status   model.CompletionStatus
+	Vouchers []Voucher
+	Notices  [][]byte
+	Reports  [][]byte
+
+	// Exception data if the status is exception.
+	Exception []byte
+
+	// Reason the machine halted if the status is machine halted.
+	HaltReason string
+}
+
+// Backend that processes the inputs in the machine.
+// Tests may implement this interface to fake the server-manager.
+type Backend interface {
+	// Process the advance input and wait for the result.
+	// The inputs are sent in order, without gaps in the indices.
+	Advance(ctx context.Context, input model.AdvanceInput) (Result, error)
+
+	// Process the inspect input and wait for the result.
+	Inspect(ctx context.Context, input model.InspectInput) (Result, error)
+}
+
+// This worker sends the inputs of the model to the backend and stores the results in the model.
+type ServerManagerWorker struct {
+	Model   *model.NonodoModel
+	Backend Backend
+}
+
+func (w ServerManagerWorker) String() string {
+	return "server-manager"
+}
+
+func (w ServerManagerWorker) Start(ctx context.Context, ready chan<- struct{}) error {
+	ready <- struct{}{}
+	accepted := true
+	for {
+		input := w.Model.FinishAndGetNext(accepted)
+		if input == nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(PollInterval):
+			}
+			continue
+		}
+		var result Result
+		var err error
+		switch input := input.(type) {
+		case model.AdvanceInput:
+			result, err = w.Backend.Advance(ctx, input)
+		case model.InspectInput:
+			result, err = w.Backend.Inspect(ctx, input)
+		default:
+			return fmt.Errorf("server-manager: invalid input type: %T", input)
+		}
+		if err != nil {
+			return fmt.Errorf("server-manager: %w", err)
+		}
+		accepted, err = w.store(result)
+		if err != nil {
+			return fmt.Errorf("server-manager: %w", err)
+		}
+	}
+}
+
+// Store the result of the current input in the model.
+// Return whether the input was accepted.
+func (w ServerManagerWorker) store(result Result) (bool, error) {
+	for _, report := range result.Reports {
+		if err := w.Model.AddReport(report); err != nil {
+			return false, err
+		}
+	}
+	switch result.Status {
+	case model.CompletionStatusAccepted:
+		for _, voucher := range result.Vouchers {
+			if _, err := w.Model.AddVoucher(voucher.Destination, voucher.Payload); err != nil {
+				return false, err
+			}
+		}
+		for _, notice := range result.Notices {
+			if _, err := w.Model.AddNotice(notice); err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	case model.CompletionStatusRejected:
+		return false, nil
+	case model.CompletionStatusException:
+		return true, w.Model.RegisterException(result.Exception)
+	case model.CompletionStatusMachineHalted:
+		slog.Warn("server-manager: machine halted", "reason", result.HaltReason)
+		w.Model.HaltCurrentInput(model.MachineHalt{
+			ExitCode: -1,
+			Reason:   result.HaltReason,
+		})
+		return true, nil
+	case model.CompletionStatusUnprocessed:
+		return false, fmt.Errorf("input was not processed")
+	default:
+		return false, fmt.Errorf("invalid completion status: %v", result.Status)
+	}
+}
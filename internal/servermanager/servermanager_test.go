@@ -0,0 +1,102 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package servermanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Backend that returns the result for each payload.
+type fakeBackend struct {
+	results map[string]Result
+}
+
+func (b fakeBackend) Advance(ctx context.Context, input model.AdvanceInput) (Result, error) {
+	return b.results[string(input.Payload)], nil
+}
+
+func (b fakeBackend) Inspect(ctx context.Context, input model.InspectInput) (Result, error) {
+	return b.results[string(input.Payload)], nil
+}
+
+func TestServerManagerWorker(t *testing.T) {
+	destination := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	backend := fakeBackend{map[string]Result{
+		"accept": {
+			Status:   model.CompletionStatusAccepted,
+			Vouchers: []Voucher{{Destination: destination, Payload: []byte("voucher")}},
+			Notices:  [][]byte{[]byte("notice")},
+			Reports:  [][]byte{[]byte("report")},
+		},
+		"reject": {
+			Status:  model.CompletionStatusRejected,
+			Notices: [][]byte{[]byte("notice")},
+			Reports: [][]byte{[]byte("report")},
+		},
+		"exception": {
+			Status:    model.CompletionStatusException,
+			Exception: []byte("boom"),
+		},
+		"halt": {
+			Status:     model.CompletionStatusMachineHalted,
+			HaltReason: "cycle limit exceeded",
+		},
+		"inspect": {
+			Status:  model.CompletionStatusAccepted,
+			Reports: [][]byte{[]byte("inspected")},
+		},
+	}}
+	m := model.NewNonodoModel()
+	for _, payload := range []string{"accept", "reject", "exception", "halt"} {
+		m.AddAdvanceInput(common.Address{}, []byte(payload), 0, time.Now())
+	}
+	m.AddInspectInput([]byte("inspect"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	result := make(chan error)
+	go func() {
+		result <- ServerManagerWorker{Model: m, Backend: backend}.Start(ctx,
+			make(chan struct{}, 1))
+	}()
+	require.Eventually(t, func() bool {
+		input, _ := m.GetAdvanceInput(3)
+		return input.Status != model.CompletionStatusUnprocessed
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-result, context.Canceled)
+
+	accepted, _ := m.GetAdvanceInput(0)
+	assert.Equal(t, model.CompletionStatusAccepted, accepted.Status)
+	require.Len(t, accepted.Vouchers, 1)
+	assert.Equal(t, destination, accepted.Vouchers[0].Destination)
+	assert.Len(t, accepted.Notices, 1)
+	assert.Len(t, accepted.Reports, 1)
+
+	rejected, _ := m.GetAdvanceInput(1)
+	assert.Equal(t, model.CompletionStatusRejected, rejected.Status)
+	assert.Empty(t, rejected.Notices)
+	assert.Len(t, rejected.Reports, 1)
+
+	exception, _ := m.GetAdvanceInput(2)
+	assert.Equal(t, model.CompletionStatusException, exception.Status)
+	assert.Equal(t, []byte("boom"), exception.Exception)
+
+	halted, _ := m.GetAdvanceInput(3)
+	assert.Equal(t, model.CompletionStatusMachineHalted, halted.Status)
+	require.NotNil(t, halted.MachineHalt)
+	assert.Equal(t, "cycle limit exceeded", halted.MachineHalt.Reason)
+
+	inspect := m.GetInspectInput(0)
+	assert.Equal(t, model.CompletionStatusAccepted, inspect.Status)
+	require.Len(t, inspect.Reports, 1)
+	assert.Equal(t, []byte("inspected"), inspect.Reports[0].Payload)
+}
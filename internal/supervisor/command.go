@@ -0,0 +1,86 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package supervisor
+
+import (
+	"os"
+	"os/exec"
+)
+
+// Number of stderr lines reported when a command exits.
+const CommandStderrTailSize = 20
+
+// Information about a command that exited on its own.
+type CommandExit struct {
+	// Exit code of the command; -1 if it was terminated by a signal.
+	ExitCode int
+
+	// Last lines the command wrote to stderr.
+	Stderr string
+
+	// Reason the command was stopped if it exceeded a resource limit; empty otherwise.
+	Reason string
+}
+
+// Build the exit information from the state of the exited process.
+// The state is nil if the command failed to start.
+func newCommandExit(state *os.ProcessState, stderr *commandLogger, reason string) CommandExit {
+	exitCode := -1
+	if state != nil {
+		exitCode = state.ExitCode()
+	}
+	return CommandExit{
+		ExitCode: exitCode,
+		Stderr:   stderr.Tail(),
+		Reason:   reason,
+	}
+}
+
+// Run the command and wait for it to exit, applying the sandbox, the limits, and the slowdown if
+// they are set.
+// Return the reason the command was stopped if it exceeded a resource limit.
+func (w CommandWorker) run(cmd *exec.Cmd) (string, error) {
+	var process *sandboxProcess
+	if w.Sandbox != nil {
+		var err error
+		process, err = w.Sandbox.prepare(w.Name, cmd)
+		if err != nil {
+			return "", err
+		}
+		defer process.close()
+	}
+	var group *limitGroup
+	if w.Limits != nil {
+		var err error
+		group, err = w.Limits.prepare(w.Name, cmd)
+		if err != nil {
+			return "", err
+		}
+		if group != nil {
+			defer group.close()
+		}
+	}
+	if err := cmd.Start(); err != nil {
+		return "", err
+	}
+	if process != nil {
+		if err := process.start(); err != nil {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+			return "", err
+		}
+	}
+	if w.Slowdown > 1 {
+		throttle := startThrottle(w.Name, cmd.Process.Pid, w.Slowdown)
+		defer throttle.stop()
+	}
+	if w.OnStart != nil {
+		w.OnStart(cmd.Process.Pid)
+	}
+	err := cmd.Wait()
+	if group != nil {
+		return group.reason(), err
+	}
+	return "", err
+}
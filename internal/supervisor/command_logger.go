@@ -9,13 +9,20 @@ import (
 	"io"
 	"log/slog"
 	"regexp"
+	"strings"
 )
 
 // Log the command output with the name as a prefix.
+// The logger also keeps the last lines, so they can be reported when the command exits.
 type commandLogger struct {
 	name     string
 	buffName string
 	buffer   bytes.Buffer
+	tailSize int
+	tail     []string
+
+	// If set, called for each line.
+	onLine func(stream string, line string)
 }
 
 func (w *commandLogger) Write(data []byte) (int, error) {
@@ -26,6 +33,8 @@ func (w *commandLogger) Write(data []byte) (int, error) {
 	for {
 		bytes, err := w.buffer.ReadBytes('\n')
 		if errors.Is(err, io.EOF) {
+			// keep the incomplete line for the next write
+			w.buffer.Write(bytes)
 			break
 		} else if err != nil {
 			return 0, err
@@ -37,7 +46,26 @@ func (w *commandLogger) Write(data []byte) (int, error) {
 		if len(line) > 0 {
 			slog.Info("command: log", "command", w.name, "buffer", w.buffName,
 				"line", line)
+			w.addToTail(line)
+			if w.onLine != nil {
+				w.onLine(w.buffName, line)
+			}
 		}
 	}
 	return len(data), nil
 }
+
+func (w *commandLogger) addToTail(line string) {
+	if w.tailSize == 0 {
+		return
+	}
+	if len(w.tail) == w.tailSize {
+		w.tail = w.tail[1:]
+	}
+	w.tail = append(w.tail, line)
+}
+
+// Get the last lines written to the logger.
+func (w *commandLogger) Tail() string {
+	return strings.Join(w.tail, "\n")
+}
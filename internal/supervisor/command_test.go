@@ -0,0 +1,120 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build !windows
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommandWorkerReportsExit(t *testing.T) {
+	var script strings.Builder
+	for i := 0; i < CommandStderrTailSize+5; i++ {
+		fmt.Fprintf(&script, "echo line%v >&2; ", i)
+	}
+	script.WriteString("printf partial >&2; exit 3")
+	var exits []CommandExit
+	w := newShellCommand("test", script.String())
+	w.OnExit = func(exit CommandExit) {
+		exits = append(exits, exit)
+	}
+	err := w.Start(context.Background(), make(chan struct{}, 1))
+	assert.NotNil(t, err)
+	assert.Len(t, exits, 1)
+	assert.Equal(t, 3, exits[0].ExitCode)
+	lines := strings.Split(exits[0].Stderr, "\n")
+	assert.Len(t, lines, CommandStderrTailSize)
+	assert.Equal(t, "line24", lines[len(lines)-1])
+}
+
+func TestCommandWorkerDoesNotReportCanceledExit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	called := false
+	w := newShellCommand("test", "sleep 10")
+	w.OnExit = func(exit CommandExit) {
+		called = true
+	}
+	ready := make(chan struct{}, 1)
+	go func() {
+		<-ready
+		cancel()
+	}()
+	err := w.Start(ctx, ready)
+	assert.Equal(t, context.Canceled, err)
+	assert.False(t, called)
+}
+
+func TestCommandWorkerReportsLogs(t *testing.T) {
+	var mutex sync.Mutex
+	var logs []string
+	w := newShellCommand("test", "echo out; echo err >&2")
+	w.OnLog = func(stream string, line string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		logs = append(logs, stream+": "+line)
+	}
+	err := w.Start(context.Background(), make(chan struct{}, 1))
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"stdout: out", "stderr: err"}, logs)
+}
+
+func TestCommandWorkerSyncsOutput(t *testing.T) {
+	const lines = 1000
+	signal := filepath.Join(t.TempDir(), "signal")
+	script := fmt.Sprintf("seq %v; echo err >&2; touch %v; sleep 10", lines, signal)
+	var mutex sync.Mutex
+	var logs []string
+	w := newShellCommand("test", script)
+	w.OutputSync = &OutputSync{}
+	w.OnLog = func(stream string, line string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		logs = append(logs, stream+": "+line)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- w.Start(ctx, make(chan struct{}, 1))
+	}()
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(signal)
+		return err == nil
+	}, 5*time.Second, time.Millisecond)
+
+	// the command wrote its output before the signal, so the sync reports all of it
+	w.OutputSync.Sync()
+	mutex.Lock()
+	assert.Len(t, logs, lines+1)
+	assert.Contains(t, logs, "stdout: 1000")
+	assert.Contains(t, logs, "stderr: err")
+	mutex.Unlock()
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+	assert.Empty(t, w.OutputSync.readers)
+}
+
+func TestCommandWorkerSlowdown(t *testing.T) {
+	const script = "i=0; while [ $i -lt 100000 ]; do i=$((i+1)); done"
+	measure := func(slowdown float64) time.Duration {
+		w := newShellCommand("test", script)
+		w.Slowdown = slowdown
+		start := time.Now()
+		assert.Nil(t, w.Start(context.Background(), make(chan struct{}, 1)))
+		return time.Since(start)
+	}
+	normal := measure(0)
+	slow := measure(5)
+	assert.Greater(t, slow, 2*normal)
+}
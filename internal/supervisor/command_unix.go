@@ -7,17 +7,46 @@ package supervisor
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"os/exec"
 	"syscall"
+	"time"
 )
 
+// Time to wait for the command to exit after sending the stop signal.
+// After this delay, the command is killed.
+const CommandStopTimeout = 3 * time.Second
+
 // This worker is responsible for a shell command that runs endlessly.
 type CommandWorker struct {
 	Name    string
 	Command string
 	Args    []string
 	Env     []string
+
+	// If set, called when the command exits before the context is canceled.
+	OnExit func(exit CommandExit)
+
+	// If set, run the command inside the sandbox.
+	Sandbox *Sandbox
+
+	// If set, limit the resources of the command.
+	Limits *Limits
+
+	// If greater than one, throttle the command so it runs this many times slower.
+	Slowdown float64
+
+	// If set, called with the process id after the command starts.
+	OnStart func(pid int)
+
+	// If set, called for each line the command writes to stdout or stderr.
+	// The stdout and stderr lines are reported concurrently.
+	OnLog func(stream string, line string)
+
+	// If set, registers the readers of stdout and stderr, so the caller can read the output the
+	// command wrote so far.
+	OutputSync *OutputSync
 }
 
 func (w CommandWorker) String() string {
@@ -27,8 +56,13 @@ func (w CommandWorker) String() string {
 func (w CommandWorker) Start(ctx context.Context, ready chan<- struct{}) error {
 	cmd := exec.CommandContext(ctx, w.Command, w.Args...)
 	cmd.Env = w.Env
-	cmd.Stderr = &commandLogger{buffName: "stdout", name: w.Name}
-	cmd.Stdout = &commandLogger{buffName: "stderr", name: w.Name}
+	stderr := &commandLogger{
+		buffName: "stderr",
+		name:     w.Name,
+		tailSize: CommandStderrTailSize,
+		onLine:   w.OnLog,
+	}
+	stdout := &commandLogger{buffName: "stdout", name: w.Name, onLine: w.OnLog}
 	// Use setpgid to create a process group, so we can send the terminate signal to the
 	// processes and all of its children. This only works on unix systems.
 	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
@@ -40,10 +74,59 @@ func (w CommandWorker) Start(ctx context.Context, ready chan<- struct{}) error {
 		}
 		return err
 	}
+	cmd.WaitDelay = CommandStopTimeout
 	ready <- struct{}{}
-	err := cmd.Run()
+	closeOutput, err := w.pipeOutput(cmd, stdout, stderr)
+	if err != nil {
+		return err
+	}
+	reason, err := w.run(cmd)
+	closeOutput()
 	if ctx.Err() != nil {
 		return ctx.Err()
 	}
+	if reason != "" {
+		slog.Warn("command: stopped", "command", w, "reason", reason)
+	}
+	if w.OnExit != nil {
+		w.OnExit(newCommandExit(cmd.ProcessState, stderr, reason))
+	}
 	return err
 }
+
+// Read the command output from pipes and register the readers in the output sync.
+// Return the function that reads the remaining output after the command exits.
+func (w CommandWorker) pipeOutput(
+	cmd *exec.Cmd,
+	stdout *commandLogger,
+	stderr *commandLogger,
+) (func(), error) {
+	var readers []*outputReader
+	closeOutput := func() {
+		for _, reader := range readers {
+			w.OutputSync.remove(reader)
+			reader.close()
+		}
+	}
+	for _, logger := range []*commandLogger{stdout, stderr} {
+		reader, err := newOutputReader(logger)
+		if err != nil {
+			closeOutput()
+			return nil, fmt.Errorf("command: failed to create pipe: %w", err)
+		}
+		readers = append(readers, reader)
+		w.OutputSync.add(reader)
+	}
+	cmd.Stdout = readers[0].pipe
+	cmd.Stderr = readers[1].pipe
+	return closeOutput, nil
+}
+
+// Create a command worker that runs the script in the system shell.
+func newShellCommand(name string, script string) CommandWorker {
+	return CommandWorker{
+		Name:    name,
+		Command: "sh",
+		Args:    []string{"-c", script},
+	}
+}
@@ -9,14 +9,42 @@ import (
 	"context"
 	"log/slog"
 	"os/exec"
+	"time"
 )
 
+// Time to wait for the command to exit after sending the stop signal.
+// After this delay, the command is killed.
+const CommandStopTimeout = 3 * time.Second
+
 // This worker is responsible for a shell command that runs endlessly.
 type CommandWorker struct {
 	Name    string
 	Command string
 	Args    []string
 	Env     []string
+
+	// If set, called when the command exits before the context is canceled.
+	OnExit func(exit CommandExit)
+
+	// If set, run the command inside the sandbox.
+	Sandbox *Sandbox
+
+	// If set, limit the resources of the command.
+	Limits *Limits
+
+	// If greater than one, throttle the command so it runs this many times slower.
+	Slowdown float64
+
+	// If set, called with the process id after the command starts.
+	OnStart func(pid int)
+
+	// If set, called for each line the command writes to stdout or stderr.
+	// The stdout and stderr lines are reported concurrently.
+	OnLog func(stream string, line string)
+
+	// If set, registers the readers of stdout and stderr, so the caller can read the output the
+	// command wrote so far. Windows doesn't support it, so the output isn't synced.
+	OutputSync *OutputSync
 }
 
 func (w CommandWorker) String() string {
@@ -26,8 +54,14 @@ func (w CommandWorker) String() string {
 func (w CommandWorker) Start(ctx context.Context, ready chan<- struct{}) error {
 	cmd := exec.CommandContext(ctx, w.Command, w.Args...)
 	cmd.Env = w.Env
-	cmd.Stderr = &commandLogger{buffName: "stdout", name: w.Name}
-	cmd.Stdout = &commandLogger{buffName: "stderr", name: w.Name}
+	stderr := &commandLogger{
+		buffName: "stderr",
+		name:     w.Name,
+		tailSize: CommandStderrTailSize,
+		onLine:   w.OnLog,
+	}
+	cmd.Stderr = stderr
+	cmd.Stdout = &commandLogger{buffName: "stdout", name: w.Name, onLine: w.OnLog}
 	cmd.Cancel = func() error {
 		// Sending Interrupt on Windows is not implemented, so we just kill the process.
 		// See: https://pkg.go.dev/os#Process.Signal
@@ -37,10 +71,26 @@ func (w CommandWorker) Start(ctx context.Context, ready chan<- struct{}) error {
 		}
 		return err
 	}
+	cmd.WaitDelay = CommandStopTimeout
 	ready <- struct{}{}
-	err := cmd.Run()
+	reason, err := w.run(cmd)
 	if ctx.Err() != nil {
 		return ctx.Err()
 	}
+	if reason != "" {
+		slog.Warn("command: stopped", "command", w, "reason", reason)
+	}
+	if w.OnExit != nil {
+		w.OnExit(newCommandExit(cmd.ProcessState, stderr, reason))
+	}
 	return err
 }
+
+// Create a command worker that runs the script in the system shell.
+func newShellCommand(name string, script string) CommandWorker {
+	return CommandWorker{
+		Name:    name,
+		Command: "cmd",
+		Args:    []string{"/C", script},
+	}
+}
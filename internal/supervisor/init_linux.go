@@ -0,0 +1,115 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build linux
+
+package supervisor
+
+// Some command options require running code in the new process before executing the command.
+// In these cases, the command worker executes the current binary again with special environment
+// variables; then, Init sets up the process and executes the command.
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// Environment variable that enables the init process.
+	initEnv = "NONODO_INIT"
+
+	// Path to the current binary.
+	initPath = "/proc/self/exe"
+
+	// Exit code of the init process when it fails to set up the command.
+	initExitCode = 125
+
+	// Minimum number of arguments of the init process: its name and the command path.
+	initMinArgs = 3
+)
+
+// Environment variables used by the init process, which are not passed to the command.
+var initEnvs = []string{initEnv, sandboxFSEnv, sandboxPortsEnv, rlimitsEnv}
+
+// Run the init process if the current process was started by a command worker.
+// The main function should call this before doing anything else.
+// In this case, this function executes the command and doesn't return.
+func Init() {
+	if _, ok := os.LookupEnv(initEnv); !ok {
+		return
+	}
+	err := commandInit()
+	fmt.Fprintf(os.Stderr, "nonodo-init: %v\n", err)
+	os.Exit(initExitCode)
+}
+
+// Set up the process and execute the command.
+// This function only returns if there is an error.
+func commandInit() error {
+	if len(os.Args) < initMinArgs {
+		return fmt.Errorf("missing command")
+	}
+	command, err := exec.LookPath(os.Args[1])
+	if err != nil {
+		return err
+	}
+	if fs, ok := os.LookupEnv(sandboxFSEnv); ok {
+		if err := sandboxInit(SandboxFS(fs), command); err != nil {
+			return err
+		}
+	}
+	if err := setRlimits(os.Getenv(rlimitsEnv)); err != nil {
+		return err
+	}
+	var env []string
+	for _, entry := range os.Environ() {
+		name, _, _ := strings.Cut(entry, "=")
+		if !slices.Contains(initEnvs, name) {
+			env = append(env, entry)
+		}
+	}
+	return unix.Exec(command, os.Args[2:], env)
+}
+
+// Change the command so it runs through the init process with the given environment variables.
+func wrapWithInit(cmd *exec.Cmd, env ...string) {
+	if cmd.Path != initPath {
+		cmd.Args = append([]string{"nonodo-init", cmd.Path}, cmd.Args...)
+		cmd.Path = initPath
+		if cmd.Env == nil {
+			cmd.Env = os.Environ()
+		}
+		cmd.Env = append(cmd.Env, initEnv+"=1")
+	}
+	cmd.Env = append(cmd.Env, env...)
+}
+
+// Set the resource limits of the process, which the command inherits.
+// The limits are encoded as a comma-separated list of resource:value pairs.
+func setRlimits(rlimits string) error {
+	if rlimits == "" {
+		return nil
+	}
+	for _, entry := range strings.Split(rlimits, ",") {
+		resourceStr, valueStr, _ := strings.Cut(entry, ":")
+		resource, err := strconv.Atoi(resourceStr)
+		if err != nil {
+			return fmt.Errorf("invalid rlimit: %v", entry)
+		}
+		value, err := strconv.ParseUint(valueStr, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid rlimit: %v", entry)
+		}
+		rlimit := unix.Rlimit{Cur: value, Max: value}
+		if err := unix.Setrlimit(resource, &rlimit); err != nil {
+			return fmt.Errorf("failed to set rlimit %v: %w", resource, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build !linux
+
+package supervisor
+
+// Run the init process if the current process was started by a command worker.
+// The init process is only used on Linux, so this function does nothing.
+func Init() {}
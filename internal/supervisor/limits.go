@@ -0,0 +1,104 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package supervisor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Mechanism used to limit the resources of a command.
+type LimitMode string
+
+const (
+	// Don't limit the resources.
+	LimitNone LimitMode = "none"
+
+	// Use resource limits (setrlimit).
+	// In this mode, the memory limit applies to the private writable memory of each process
+	// (RLIMIT_DATA) and the CPU is not limited.
+	LimitRlimit LimitMode = "rlimit"
+
+	// Use a cgroup v2 for the command and its children.
+	// Nonodo should have write access to its own cgroup.
+	LimitCgroup LimitMode = "cgroup"
+)
+
+// Parse the limit mode from a string.
+func ParseLimitMode(value string) (LimitMode, error) {
+	switch mode := LimitMode(value); mode {
+	case LimitNone, LimitRlimit, LimitCgroup:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid limit mode: %v", value)
+	}
+}
+
+// Resource limits of a command.
+// The zero value of each limit means no limit.
+type Limits struct {
+	Mode LimitMode
+
+	// Memory limit in bytes.
+	Memory int64
+
+	// CPU limit as a number of CPUs; for instance, 0.5 means half of a CPU.
+	CPU float64
+
+	// Maximum number of open files for each process.
+	OpenFiles uint64
+}
+
+// The Linux kernel sets the maximum number of open files to 10% of the RAM size in KiB.
+const openFilesPerRAMByte = 10 * 1024
+
+// Get the limits of a Cartesi machine with the given RAM size.
+// The machine has a single CPU.
+func MachineLimits(mode LimitMode, ramSize int64) Limits {
+	return Limits{
+		Mode:      mode,
+		Memory:    ramSize,
+		CPU:       1,
+		OpenFiles: uint64(ramSize / openFilesPerRAMByte),
+	}
+}
+
+// Units of byte sizes, from the largest to the smallest.
+var byteSizeUnits = []struct {
+	suffix string
+	size   int64
+}{
+	{"Gi", 1 << 30},
+	{"Mi", 1 << 20},
+	{"Ki", 1 << 10},
+}
+
+// Parse a size in bytes, which might have one of the suffixes Ki, Mi, or Gi.
+func ParseByteSize(value string) (int64, error) {
+	number := value
+	multiplier := int64(1)
+	for _, unit := range byteSizeUnits {
+		if strings.HasSuffix(value, unit.suffix) {
+			number = strings.TrimSuffix(value, unit.suffix)
+			multiplier = unit.size
+			break
+		}
+	}
+	size, err := strconv.ParseInt(number, 10, 64)
+	if err != nil || size < 0 {
+		return 0, fmt.Errorf("invalid size: %v", value)
+	}
+	return size * multiplier, nil
+}
+
+// Format the size in bytes using the largest exact unit.
+func FormatByteSize(size int64) string {
+	for _, unit := range byteSizeUnits {
+		if size != 0 && size%unit.size == 0 {
+			return fmt.Sprintf("%v%v", size/unit.size, unit.suffix)
+		}
+	}
+	return fmt.Sprint(size)
+}
@@ -0,0 +1,274 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build linux
+
+package supervisor
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// Period used to compute the CPU quota of the cgroup.
+	cgroupCPUPeriod = 100000
+
+	// Attempts to remove the cgroup while its processes are being killed.
+	cgroupRemoveAttempts = 10
+
+	// Delay between the attempts to remove the cgroup.
+	cgroupRemoveDelay = 10 * time.Millisecond
+
+	// Permissions of the cgroup directories.
+	cgroupDirPermissions = 0755
+
+	// Environment variable with the resource limits set by the init process.
+	rlimitsEnv = "NONODO_RLIMITS"
+
+	// Possible mount points of the cgroup v2 hierarchy.
+	cgroupMountPoint       = "/sys/fs/cgroup"
+	cgroupHybridMountPoint = "/sys/fs/cgroup/unified"
+)
+
+// Cgroup that contains the command cgroups; nonodo sets it up once.
+var limitParentCgroup struct {
+	once sync.Once
+	dir  string
+	err  error
+}
+
+// Resources that limit a running command.
+type limitGroup struct {
+	limits Limits
+	name   string
+
+	// Cgroup directory and file descriptor; only used in cgroup mode.
+	dir string
+	fd  int
+}
+
+// Prepare the command to run with the resource limits.
+func (l Limits) prepare(name string, cmd *exec.Cmd) (*limitGroup, error) {
+	group := &limitGroup{
+		limits: l,
+		name:   name,
+		fd:     -1,
+	}
+	switch l.Mode {
+	case LimitNone:
+		return nil, nil
+	case LimitRlimit:
+	case LimitCgroup:
+		if err := group.createCgroup(); err != nil {
+			group.close()
+			return nil, err
+		}
+		if cmd.SysProcAttr == nil {
+			cmd.SysProcAttr = &syscall.SysProcAttr{}
+		}
+		cmd.SysProcAttr.UseCgroupFD = true
+		cmd.SysProcAttr.CgroupFD = group.fd
+	default:
+		return nil, fmt.Errorf("limits: invalid mode: %v", l.Mode)
+	}
+
+	// The init process sets the rlimits before executing the command, so they apply from the start.
+	var rlimits []string
+	if l.Mode == LimitRlimit && l.Memory != 0 {
+		rlimits = append(rlimits, fmt.Sprintf("%v:%v", unix.RLIMIT_DATA, l.Memory))
+	}
+	if l.OpenFiles != 0 {
+		rlimits = append(rlimits, fmt.Sprintf("%v:%v", unix.RLIMIT_NOFILE, l.OpenFiles))
+	}
+	if len(rlimits) > 0 {
+		wrapWithInit(cmd, fmt.Sprintf("%v=%v", rlimitsEnv, strings.Join(rlimits, ",")))
+	}
+	return group, nil
+}
+
+// Get the reason the command was stopped if it exceeded a limit.
+// Return an empty string otherwise.
+func (g *limitGroup) reason() string {
+	if g.dir == "" {
+		return ""
+	}
+	events, err := readKeyValues(filepath.Join(g.dir, "memory.events"))
+	if err != nil {
+		slog.Warn("limits: failed to read memory events", "command", g.name, "error", err)
+		return ""
+	}
+	if events["oom_kill"] > 0 || events["oom_group_kill"] > 0 {
+		return "memory limit exceeded"
+	}
+	return ""
+}
+
+// Release the limit resources, killing the remaining processes in the cgroup.
+func (g *limitGroup) close() {
+	if g.fd >= 0 {
+		unix.Close(g.fd)
+		g.fd = -1
+	}
+	if g.dir == "" {
+		return
+	}
+	_ = writeCgroupFile(filepath.Join(g.dir, "cgroup.kill"), "1")
+	var err error
+	for i := 0; i < cgroupRemoveAttempts; i++ {
+		if err = unix.Rmdir(g.dir); err == nil || errors.Is(err, unix.ENOENT) {
+			return
+		}
+		time.Sleep(cgroupRemoveDelay)
+	}
+	slog.Warn("limits: failed to remove cgroup", "command", g.name, "dir", g.dir, "error", err)
+}
+
+// Create a cgroup for the command inside the nonodo cgroup.
+func (g *limitGroup) createCgroup() error {
+	limitParentCgroup.once.Do(func() {
+		limitParentCgroup.dir, limitParentCgroup.err = ownCgroup()
+		if limitParentCgroup.err == nil {
+			limitParentCgroup.err = enableControllers(limitParentCgroup.dir)
+		}
+	})
+	if limitParentCgroup.err != nil {
+		return limitParentCgroup.err
+	}
+	name := fmt.Sprintf("nonodo-%v-%v", os.Getpid(), g.name)
+	g.dir = filepath.Join(limitParentCgroup.dir, name)
+	err := os.Mkdir(g.dir, cgroupDirPermissions)
+	if err != nil && !errors.Is(err, os.ErrExist) {
+		g.dir = ""
+		return fmt.Errorf("limits: failed to create cgroup: %w", err)
+	}
+
+	files := make(map[string]string)
+	if g.limits.Memory != 0 {
+		files["memory.max"] = fmt.Sprint(g.limits.Memory)
+		files["memory.swap.max"] = "0"
+		// kill all processes when one of them exceeds the memory limit
+		files["memory.oom.group"] = "1"
+	}
+	if g.limits.CPU != 0 {
+		quota := int64(g.limits.CPU * cgroupCPUPeriod)
+		files["cpu.max"] = fmt.Sprintf("%v %v", quota, cgroupCPUPeriod)
+	}
+	for file, value := range files {
+		err := writeCgroupFile(filepath.Join(g.dir, file), value)
+		if errors.Is(err, os.ErrNotExist) && file == "memory.swap.max" {
+			continue // swap accounting is disabled
+		} else if err != nil {
+			return fmt.Errorf("limits: failed to set %v: %w", file, err)
+		}
+	}
+
+	g.fd, err = unix.Open(g.dir, unix.O_RDONLY|unix.O_DIRECTORY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return fmt.Errorf("limits: failed to open cgroup: %w", err)
+	}
+	return nil
+}
+
+// Get the directory of the cgroup v2 of the current process.
+func ownCgroup() (string, error) {
+	mountPoint := cgroupMountPoint
+	if _, err := os.Stat(filepath.Join(mountPoint, "cgroup.controllers")); err != nil {
+		mountPoint = cgroupHybridMountPoint
+	}
+	data, err := os.ReadFile("/proc/self/cgroup")
+	if err != nil {
+		return "", fmt.Errorf("limits: failed to read cgroup: %w", err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if path, ok := strings.CutPrefix(line, "0::"); ok {
+			return filepath.Join(mountPoint, path), nil
+		}
+	}
+	return "", fmt.Errorf("limits: cgroup v2 not found")
+}
+
+// Enable the memory and CPU controllers for the children of the cgroup.
+// Cgroup v2 only allows that when the cgroup has no processes, so this function moves nonodo
+// to a child cgroup if needed.
+func enableControllers(dir string) error {
+	controllers, err := os.ReadFile(filepath.Join(dir, "cgroup.controllers"))
+	if err != nil {
+		return fmt.Errorf("limits: failed to read cgroup controllers: %w", err)
+	}
+	available := strings.Fields(string(controllers))
+	for _, controller := range []string{"memory", "cpu"} {
+		if !slices.Contains(available, controller) {
+			return fmt.Errorf("limits: cgroup v2 %v controller is not available in %v",
+				controller, dir)
+		}
+	}
+	subtreeControl := filepath.Join(dir, "cgroup.subtree_control")
+	const enable = "+memory +cpu"
+	err = writeCgroupFile(subtreeControl, enable)
+	if errors.Is(err, unix.EBUSY) {
+		leaf := filepath.Join(dir, "nonodo")
+		mkdirErr := os.Mkdir(leaf, cgroupDirPermissions)
+		if mkdirErr != nil && !errors.Is(mkdirErr, os.ErrExist) {
+			return fmt.Errorf("limits: failed to create cgroup: %w", mkdirErr)
+		}
+		pid := fmt.Sprint(os.Getpid())
+		if err := writeCgroupFile(filepath.Join(leaf, "cgroup.procs"), pid); err != nil {
+			return fmt.Errorf("limits: failed to move nonodo to %v: %w", leaf, err)
+		}
+		err = writeCgroupFile(subtreeControl, enable)
+	}
+	if err != nil {
+		return fmt.Errorf("limits: failed to enable cgroup controllers in %v: %w", dir, err)
+	}
+	return nil
+}
+
+// Write the value to an existing cgroup file.
+func writeCgroupFile(path string, value string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	_, err = file.WriteString(value)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// Read a file with a key and a value separated by a space per line, like cgroup and proc files.
+func readKeyValues(path string) (map[string]int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	values := make(map[string]int64)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), " ")
+		if !ok {
+			continue
+		}
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		values[key] = number
+	}
+	return values, scanner.Err()
+}
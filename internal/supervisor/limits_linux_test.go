@@ -0,0 +1,51 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build linux
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRlimitLimits(t *testing.T) {
+	limits := &Limits{
+		Mode:      LimitRlimit,
+		Memory:    256 << 20,
+		OpenFiles: 64,
+	}
+	script := fmt.Sprintf("test $(ulimit -n) -eq %v && test $(ulimit -d) -eq %v",
+		limits.OpenFiles, limits.Memory>>10)
+	w := newShellCommand("test", script)
+	w.Limits = limits
+	assert.Nil(t, w.Start(context.Background(), make(chan struct{}, 1)))
+}
+
+func TestCgroupLimits(t *testing.T) {
+	limits := &Limits{
+		Mode:   LimitCgroup,
+		Memory: 32 << 20,
+	}
+	group, err := limits.prepare("test", exec.Command("true"))
+	if err != nil {
+		t.Skipf("cgroup v2 not available: %v", err)
+	}
+	group.close()
+
+	// allocate more memory than the limit
+	var exits []CommandExit
+	w := newShellCommand("test", "head -c 64m /dev/zero | tail > /dev/null")
+	w.Limits = limits
+	w.OnExit = func(exit CommandExit) {
+		exits = append(exits, exit)
+	}
+	assert.NotNil(t, w.Start(context.Background(), make(chan struct{}, 1)))
+	assert.Len(t, exits, 1)
+	assert.Equal(t, "memory limit exceeded", exits[0].Reason)
+}
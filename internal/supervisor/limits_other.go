@@ -0,0 +1,26 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build !linux
+
+package supervisor
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+type limitGroup struct{}
+
+func (l Limits) prepare(name string, cmd *exec.Cmd) (*limitGroup, error) {
+	if l.Mode == LimitNone {
+		return nil, nil
+	}
+	return nil, fmt.Errorf("limits: only supported on Linux")
+}
+
+func (g *limitGroup) reason() string {
+	return ""
+}
+
+func (g *limitGroup) close() {}
@@ -0,0 +1,50 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package supervisor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseByteSize(t *testing.T) {
+	valid := map[string]int64{
+		"0":      0,
+		"4096":   4096,
+		"64Ki":   64 << 10,
+		"128Mi":  128 << 20,
+		"2Gi":    2 << 30,
+		"1025Mi": 1025 << 20,
+	}
+	for value, expected := range valid {
+		size, err := ParseByteSize(value)
+		assert.Nil(t, err, value)
+		assert.Equal(t, expected, size, value)
+		parsed, err := ParseByteSize(FormatByteSize(size))
+		assert.Nil(t, err, value)
+		assert.Equal(t, size, parsed, value)
+	}
+	for _, value := range []string{"", "Mi", "-1", "1.5Gi", "10MB"} {
+		_, err := ParseByteSize(value)
+		assert.NotNil(t, err, value)
+	}
+}
+
+func TestFormatByteSize(t *testing.T) {
+	assert.Equal(t, "0", FormatByteSize(0))
+	assert.Equal(t, "1000", FormatByteSize(1000))
+	assert.Equal(t, "1Ki", FormatByteSize(1024))
+	assert.Equal(t, "1536Ki", FormatByteSize(1536<<10))
+	assert.Equal(t, "128Mi", FormatByteSize(128<<20))
+	assert.Equal(t, "1Gi", FormatByteSize(1<<30))
+}
+
+func TestMachineLimits(t *testing.T) {
+	limits := MachineLimits(LimitCgroup, 128<<20)
+	assert.Equal(t, LimitCgroup, limits.Mode)
+	assert.Equal(t, int64(128<<20), limits.Memory)
+	assert.Equal(t, float64(1), limits.CPU)
+	assert.Equal(t, uint64(13107), limits.OpenFiles)
+}
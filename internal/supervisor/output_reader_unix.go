@@ -0,0 +1,99 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build !windows
+
+package supervisor
+
+import (
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// Size of the buffer used to read the command output.
+const outputBufferSize = 32 << 10
+
+// Reads a command output from a pipe and writes it to the logger.
+// Both the reader goroutine and the sync read the pipe while holding the lock, so the logger
+// receives the output in order.
+type outputReader struct {
+	file   *os.File
+	pipe   *os.File
+	conn   syscall.RawConn
+	logger io.Writer
+	done   chan struct{}
+
+	mutex  sync.Mutex
+	buffer []byte
+}
+
+// Create the pipe of a command output and start reading it.
+// The command should write to the pipe field.
+func newOutputReader(logger io.Writer) (*outputReader, error) {
+	file, pipe, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := file.SyscallConn()
+	if err != nil {
+		file.Close()
+		pipe.Close()
+		return nil, err
+	}
+	r := &outputReader{
+		file:   file,
+		pipe:   pipe,
+		conn:   conn,
+		logger: logger,
+		done:   make(chan struct{}),
+		buffer: make([]byte, outputBufferSize),
+	}
+	go r.run()
+	return r, nil
+}
+
+func (r *outputReader) run() {
+	defer close(r.done)
+	// the poller calls the function again when there is more output
+	_ = r.conn.Read(r.read)
+}
+
+// Read the output available in the pipe.
+func (r *outputReader) sync() {
+	_ = r.conn.Control(func(fd uintptr) {
+		r.read(fd)
+	})
+}
+
+// Read the output the command wrote so far and stop reading.
+// This should be called after the command exits.
+func (r *outputReader) close() {
+	r.pipe.Close()
+	r.sync()
+	r.file.Close()
+	<-r.done
+}
+
+// Read the output available in the pipe without blocking.
+// Return true if the pipe was closed or failed.
+func (r *outputReader) read(fd uintptr) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for {
+		n, err := unix.Read(int(fd), r.buffer)
+		if n > 0 {
+			_, _ = r.logger.Write(r.buffer[:n])
+			continue
+		}
+		if errors.Is(err, unix.EINTR) {
+			continue
+		}
+		return !errors.Is(err, unix.EAGAIN)
+	}
+}
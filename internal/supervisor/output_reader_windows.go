@@ -0,0 +1,11 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build windows
+
+package supervisor
+
+// On Windows, os/exec reads the command output, so there is nothing to sync.
+type outputReader struct{}
+
+func (r *outputReader) sync() {}
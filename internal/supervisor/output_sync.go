@@ -0,0 +1,55 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package supervisor
+
+import (
+	"sync"
+)
+
+// Synchronizes the output of a command with its requests.
+// The supervisor reads the output asynchronously, so it might not have read the lines the command
+// wrote right before sending a request. Before handling the request, Sync reads the output the
+// command wrote so far.
+type OutputSync struct {
+	mutex   sync.Mutex
+	readers []*outputReader
+}
+
+// Read the output the command wrote so far and report its lines.
+func (s *OutputSync) Sync() {
+	s.mutex.Lock()
+	readers := append([]*outputReader(nil), s.readers...)
+	s.mutex.Unlock()
+
+	for _, reader := range readers {
+		reader.sync()
+	}
+}
+
+// Register the reader of a command output; do nothing if the sync is nil.
+func (s *OutputSync) add(reader *outputReader) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.readers = append(s.readers, reader)
+}
+
+// Unregister the reader of a command output; do nothing if the sync is nil.
+func (s *OutputSync) remove(reader *outputReader) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for i := range s.readers {
+		if s.readers[i] == reader {
+			s.readers = append(s.readers[:i], s.readers[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package supervisor
+
+import (
+	"time"
+)
+
+// Resources used by the processes in a group at a given moment.
+type ProcessUsage struct {
+	// CPU time used by the processes, including their waited-for children.
+	CPUTime time.Duration
+
+	// Resident memory of the processes in bytes.
+	Memory int64
+
+	// Bytes the processes read from and wrote to the storage.
+	ReadBytes  int64
+	WriteBytes int64
+}
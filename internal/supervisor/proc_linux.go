@@ -0,0 +1,106 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build linux
+
+package supervisor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Number of clock ticks per second used by /proc; Linux fixes it at 100 for user space.
+	procClockTicks = 100
+
+	// Indexes of the fields of /proc/<pid>/stat after the command name.
+	procStatPgrp   = 2
+	procStatUtime  = 11
+	procStatCstime = 14
+	procStatRSS    = 21
+)
+
+// Get the resources used by the processes in the group.
+// The group leader's PID must be the group ID. Instead of scanning every process, the function
+// walks the descendants of the leader that remain in the group.
+func ProcessGroupUsage(pgid int) (ProcessUsage, error) {
+	var usage ProcessUsage
+	var ticks uint64
+	found := false
+	pids := []int{pgid}
+	for len(pids) > 0 {
+		pid := pids[len(pids)-1]
+		pids = pids[:len(pids)-1]
+		dir := fmt.Sprintf("/proc/%v", pid)
+		fields, err := readProcStat(filepath.Join(dir, "stat"))
+		if err != nil || len(fields) <= procStatRSS || fields[procStatPgrp] != fmt.Sprint(pgid) {
+			continue // the process might have exited or left the group
+		}
+		found = true
+		// sum utime, stime, cutime, and cstime
+		for _, field := range fields[procStatUtime : procStatCstime+1] {
+			value, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return usage, fmt.Errorf("invalid stat in %v: %w", dir, err)
+			}
+			ticks += value
+		}
+		pages, err := strconv.ParseInt(fields[procStatRSS], 10, 64)
+		if err != nil {
+			return usage, fmt.Errorf("invalid stat in %v: %w", dir, err)
+		}
+		usage.Memory += pages * int64(os.Getpagesize())
+		// reading the I/O counters might not be allowed, so they are optional
+		if io, err := readKeyValues(filepath.Join(dir, "io")); err == nil {
+			usage.ReadBytes += io["read_bytes:"]
+			usage.WriteBytes += io["write_bytes:"]
+		}
+		pids = append(pids, readProcChildren(dir)...)
+	}
+	if !found {
+		return usage, fmt.Errorf("process group %v not found", pgid)
+	}
+	usage.CPUTime = time.Duration(ticks) * time.Second / procClockTicks
+	return usage, nil
+}
+
+// Read the PIDs of the children of all threads of the process.
+// Return an empty list if the kernel doesn't expose the children or the process exited.
+func readProcChildren(dir string) []int {
+	files, err := filepath.Glob(filepath.Join(dir, "task", "[0-9]*", "children"))
+	if err != nil {
+		return nil
+	}
+	var children []int
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			continue // the thread might have exited
+		}
+		for _, field := range strings.Fields(string(data)) {
+			if pid, err := strconv.Atoi(field); err == nil {
+				children = append(children, pid)
+			}
+		}
+	}
+	return children
+}
+
+// Read the fields of /proc/<pid>/stat that come after the command name.
+// The command name might contain spaces, so the fields start after its closing parenthesis.
+func readProcStat(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	index := strings.LastIndexByte(string(data), ')')
+	if index < 0 {
+		return nil, fmt.Errorf("invalid stat %v", path)
+	}
+	return strings.Fields(string(data[index+1:])), nil
+}
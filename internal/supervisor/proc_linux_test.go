@@ -0,0 +1,28 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build linux
+
+package supervisor
+
+import (
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProcessGroupUsage(t *testing.T) {
+	// burn some CPU, so the test process group has a measurable CPU time
+	deadline := time.Now().Add(50 * time.Millisecond)
+	for time.Now().Before(deadline) {
+	}
+	usage, err := ProcessGroupUsage(syscall.Getpgrp())
+	assert.Nil(t, err)
+	assert.Greater(t, usage.CPUTime, time.Duration(0))
+	assert.Greater(t, usage.Memory, int64(0))
+
+	_, err = ProcessGroupUsage(1 << 30)
+	assert.NotNil(t, err)
+}
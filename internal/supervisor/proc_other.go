@@ -0,0 +1,16 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build !linux
+
+package supervisor
+
+import (
+	"fmt"
+)
+
+// Get the resources used by the processes in the group.
+// This is only supported on Linux.
+func ProcessGroupUsage(pgid int) (ProcessUsage, error) {
+	return ProcessUsage{}, fmt.Errorf("process group usage is only supported on Linux")
+}
@@ -0,0 +1,140 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Default delay before the first restart.
+const DefaultRestartBackoff = 500 * time.Millisecond
+
+// Maximum delay between restarts.
+// If the worker runs for longer than this, the delay goes back to the initial value.
+const MaxRestartBackoff = 30 * time.Second
+
+// Factor that multiplies the delay after each restart.
+const restartBackoffFactor = 2
+
+// Policy that defines when a worker should be restarted.
+type RestartPolicy string
+
+const (
+	RestartNever     RestartPolicy = "never"
+	RestartOnFailure RestartPolicy = "on-failure"
+	RestartAlways    RestartPolicy = "always"
+)
+
+// Parse the restart policy from a string.
+func ParseRestartPolicy(value string) (RestartPolicy, error) {
+	switch policy := RestartPolicy(value); policy {
+	case RestartNever, RestartOnFailure, RestartAlways:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid restart policy: %v", value)
+	}
+}
+
+// This worker restarts the inner worker when it exits, according to the restart policy.
+// The delay between restarts grows exponentially.
+// When the worker doesn't need to be restarted, this worker returns the inner worker result;
+// so, the supervisor only stops the other workers after the inner worker gives up.
+type RestartWorker struct {
+	Worker Worker
+	Policy RestartPolicy
+
+	// Maximum number of restarts; zero means no limit.
+	MaxRestarts int
+
+	// Delay before the first restart; zero means DefaultRestartBackoff.
+	Backoff time.Duration
+
+	// If set, called before restarting the worker.
+	OnRestart func()
+}
+
+func (w RestartWorker) String() string {
+	return w.Worker.String()
+}
+
+func (w RestartWorker) Start(ctx context.Context, ready chan<- struct{}) error {
+	initialBackoff := w.Backoff
+	if initialBackoff == 0 {
+		initialBackoff = DefaultRestartBackoff
+	}
+	backoff := initialBackoff
+	var readyOnce sync.Once
+	for restarts := 0; ; restarts++ {
+		startTime := time.Now()
+		err := w.run(ctx, ready, &readyOnce)
+		if ctx.Err() != nil {
+			return err
+		}
+		if !w.shouldRestart(err) {
+			return err
+		}
+		if w.MaxRestarts > 0 && restarts >= w.MaxRestarts {
+			slog.Error("supervisor: worker reached the restart limit", "worker", w,
+				"restarts", restarts, "error", err)
+			return err
+		}
+		if time.Since(startTime) > MaxRestartBackoff {
+			backoff = initialBackoff
+		}
+		slog.Warn("supervisor: restarting worker", "worker", w, "error", err,
+			"restarts", restarts+1, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*restartBackoffFactor, MaxRestartBackoff)
+		if w.OnRestart != nil {
+			w.OnRestart()
+		}
+	}
+}
+
+// Run the inner worker once, forwarding its first ready signal.
+func (w RestartWorker) run(ctx context.Context, ready chan<- struct{}, once *sync.Once) error {
+	innerReady := make(chan struct{}, 1)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-innerReady:
+		case <-done:
+			// the worker might have sent the ready signal right before exiting
+			select {
+			case <-innerReady:
+			default:
+				return
+			}
+		}
+		once.Do(func() {
+			select {
+			case ready <- struct{}{}:
+			case <-ctx.Done():
+			}
+		})
+	}()
+	return w.Worker.Start(ctx, innerReady)
+}
+
+func (w RestartWorker) shouldRestart(err error) bool {
+	switch w.Policy {
+	case RestartAlways:
+		return true
+	case RestartOnFailure:
+		return err != nil
+	case RestartNever:
+		return false
+	default:
+		return false
+	}
+}
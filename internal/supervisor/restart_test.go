@@ -0,0 +1,114 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Worker that exits right away with the given results.
+type fakeWorker struct {
+	results []error
+	starts  *int
+}
+
+func (w fakeWorker) String() string {
+	return "fake"
+}
+
+func (w fakeWorker) Start(ctx context.Context, ready chan<- struct{}) error {
+	ready <- struct{}{}
+	index := *w.starts
+	*w.starts++
+	if index < len(w.results) {
+		return w.results[index]
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func runRestartWorker(t *testing.T, w RestartWorker) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	w.Backoff = time.Millisecond
+	ready := make(chan struct{})
+	result := make(chan error)
+	go func() {
+		result <- w.Start(ctx, ready)
+	}()
+	select {
+	case <-ready:
+	case <-ctx.Done():
+		t.Fatal("worker never got ready")
+	}
+	select {
+	case err := <-result:
+		return err
+	case <-time.After(100 * time.Millisecond):
+		cancel()
+		return <-result
+	}
+}
+
+func TestRestartWorkerNever(t *testing.T) {
+	starts := 0
+	failure := errors.New("failure")
+	err := runRestartWorker(t, RestartWorker{
+		Worker: fakeWorker{[]error{failure}, &starts},
+		Policy: RestartNever,
+	})
+	assert.Equal(t, failure, err)
+	assert.Equal(t, 1, starts)
+}
+
+func TestRestartWorkerOnFailure(t *testing.T) {
+	starts := 0
+	restarts := 0
+	failure := errors.New("failure")
+	err := runRestartWorker(t, RestartWorker{
+		Worker:    fakeWorker{[]error{failure, failure, nil}, &starts},
+		Policy:    RestartOnFailure,
+		OnRestart: func() { restarts++ },
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, starts)
+	assert.Equal(t, 2, restarts)
+}
+
+func TestRestartWorkerAlways(t *testing.T) {
+	starts := 0
+	err := runRestartWorker(t, RestartWorker{
+		Worker: fakeWorker{[]error{nil, errors.New("failure"), nil}, &starts},
+		Policy: RestartAlways,
+	})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 4, starts)
+}
+
+func TestRestartWorkerMaxRestarts(t *testing.T) {
+	starts := 0
+	failure := errors.New("failure")
+	err := runRestartWorker(t, RestartWorker{
+		Worker:      fakeWorker{[]error{failure, failure, failure, failure}, &starts},
+		Policy:      RestartOnFailure,
+		MaxRestarts: 2,
+	})
+	assert.Equal(t, failure, err)
+	assert.Equal(t, 3, starts)
+}
+
+func TestParseRestartPolicy(t *testing.T) {
+	for _, value := range []string{"never", "on-failure", "always"} {
+		policy, err := ParseRestartPolicy(value)
+		assert.Nil(t, err)
+		assert.Equal(t, RestartPolicy(value), policy)
+	}
+	_, err := ParseRestartPolicy("sometimes")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package supervisor
+
+import (
+	"fmt"
+)
+
+// View of the file system inside the sandbox.
+type SandboxFS string
+
+const (
+	// The command sees the host file system as it is.
+	SandboxFSHost SandboxFS = "host"
+
+	// The command sees the host file system in read-only mode.
+	SandboxFSReadOnly SandboxFS = "readonly"
+
+	// The command sees a writable in-memory root file system that is discarded after it exits.
+	// Only the system directories, the working directory, and the command directory are
+	// mounted from the host, in read-only mode.
+	SandboxFSPrivate SandboxFS = "private"
+)
+
+// Parse the sandbox file system view from a string.
+func ParseSandboxFS(value string) (SandboxFS, error) {
+	switch fs := SandboxFS(value); fs {
+	case SandboxFSHost, SandboxFSReadOnly, SandboxFSPrivate:
+		return fs, nil
+	default:
+		return "", fmt.Errorf("invalid sandbox file system: %v", value)
+	}
+}
+
+// Options to run a command isolated from the host, like the Cartesi machine would.
+// The command doesn't have network access, except for the forwarded addresses.
+// The sandbox is only supported on Linux.
+type Sandbox struct {
+	// Host TCP addresses that the command may access.
+	// Each address is available in the sandbox loopback interface with the same port.
+	Forward []string
+
+	// View of the file system inside the sandbox.
+	FS SandboxFS
+}
@@ -0,0 +1,655 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build linux
+
+package supervisor
+
+// The sandbox runs the command in new user, network, and mount namespaces.
+// Setting up the namespaces requires running code inside them before starting the command, so
+// the sandbox runs the command through the init process, which configures the namespaces.
+//
+// Inside the network namespace, the init process creates a TCP listener on the loopback
+// interface for each forwarded address and a TUN device that works as the default route.
+// The init process sends these file descriptors to nonodo through a unix socket, so nonodo
+// forwards the connections to the host and logs the packets sent to the TUN device.
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// Environment variable with the file system view.
+	sandboxFSEnv = "NONODO_SANDBOX_FS"
+
+	// Environment variable with the ports of the forwarded addresses.
+	sandboxPortsEnv = "NONODO_SANDBOX_PORTS"
+
+	// File descriptor of the unix socket used to send the file descriptors to nonodo.
+	sandboxSocketFd = 3
+
+	// Name of the TUN device that receives the packets sent outside the sandbox.
+	sandboxTunName = "tun0"
+
+	// Maximum size of a packet read from the TUN device.
+	sandboxMaxPacketSize = 65536
+
+	// Size of a file descriptor in a control message.
+	sandboxFdSize = 4
+)
+
+// Address of the TUN device inside the sandbox.
+var sandboxTunAddress = []byte{10, 0, 2, 15}
+
+// Host directories mounted in the private file system view, besides the working directory and
+// the command directory.
+var sandboxSystemDirs = []string{
+	"/bin", "/dev", "/etc", "/lib", "/lib32", "/lib64", "/libx32",
+	"/opt", "/proc", "/sbin", "/sys", "/usr",
+}
+
+//
+// Nonodo side
+//
+
+// Resources of a sandboxed process in the nonodo side.
+type sandboxProcess struct {
+	name      string
+	forward   []string
+	socket    *os.File
+	child     *os.File
+	listeners []net.Listener
+	tun       *os.File
+	wg        sync.WaitGroup
+}
+
+// Change the command so it runs inside the sandbox.
+func (s Sandbox) prepare(name string, cmd *exec.Cmd) (*sandboxProcess, error) {
+	var ports []string
+	for _, address := range s.Forward {
+		_, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, fmt.Errorf("sandbox: invalid address: %w", err)
+		}
+		ports = append(ports, port)
+	}
+	fds, err := unix.Socketpair(unix.AF_UNIX, unix.SOCK_STREAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("sandbox: failed to create socket: %w", err)
+	}
+	fs := s.FS
+	if fs == "" {
+		fs = SandboxFSHost
+	}
+
+	wrapWithInit(cmd,
+		fmt.Sprintf("%v=%v", sandboxFSEnv, fs),
+		fmt.Sprintf("%v=%v", sandboxPortsEnv, strings.Join(ports, ",")),
+	)
+
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	attr := cmd.SysProcAttr
+	attr.Cloneflags |= syscall.CLONE_NEWUSER | syscall.CLONE_NEWNET | syscall.CLONE_NEWNS
+	attr.UidMappings = []syscall.SysProcIDMap{
+		{ContainerID: os.Getuid(), HostID: os.Getuid(), Size: 1},
+	}
+	attr.GidMappings = []syscall.SysProcIDMap{
+		{ContainerID: os.Getgid(), HostID: os.Getgid(), Size: 1},
+	}
+	attr.GidMappingsEnableSetgroups = false
+	// The init process needs these capabilities to set up the namespaces.
+	// It drops them before executing the command.
+	attr.AmbientCaps = []uintptr{unix.CAP_SYS_ADMIN, unix.CAP_NET_ADMIN}
+
+	p := &sandboxProcess{
+		name:    name,
+		forward: s.Forward,
+		socket:  os.NewFile(uintptr(fds[0]), "sandbox"),
+		child:   os.NewFile(uintptr(fds[1]), "sandbox-child"),
+	}
+	cmd.ExtraFiles = []*os.File{p.child}
+	return p, nil
+}
+
+// Receive the file descriptors from the init process and start serving them.
+// This should be called after starting the command.
+func (p *sandboxProcess) start() error {
+	p.child.Close()
+	// The init process sends the listeners and, if it could create it, the TUN device.
+	numFds := len(p.forward) + 1
+	buf := make([]byte, 1)
+	oob := make([]byte, unix.CmsgSpace(numFds*sandboxFdSize))
+	n, oobn, _, _, err := unix.Recvmsg(int(p.socket.Fd()), buf, oob, unix.MSG_CMSG_CLOEXEC)
+	if err != nil {
+		return fmt.Errorf("sandbox: failed to receive file descriptors: %w", err)
+	}
+	if n == 0 {
+		// the init process exited before sending the message
+		return fmt.Errorf("sandbox: failed to set up")
+	}
+	fds, err := parseRights(oob[:oobn])
+	if err != nil {
+		return err
+	}
+	if len(fds) != numFds && len(fds) != len(p.forward) {
+		for _, fd := range fds {
+			unix.Close(fd)
+		}
+		return fmt.Errorf("sandbox: expected %v file descriptors; got %v", numFds, len(fds))
+	}
+
+	for i, address := range p.forward {
+		file := os.NewFile(uintptr(fds[i]), "sandbox-listener")
+		listener, err := net.FileListener(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("sandbox: invalid listener: %w", err)
+		}
+		p.listeners = append(p.listeners, listener)
+		p.wg.Add(1)
+		go func(address string) {
+			defer p.wg.Done()
+			p.serve(listener, address)
+		}(address)
+	}
+
+	if len(fds) == len(p.forward) {
+		return nil
+	}
+	tunFd := fds[len(fds)-1]
+	if err := unix.SetNonblock(tunFd, true); err != nil {
+		unix.Close(tunFd)
+		return fmt.Errorf("sandbox: failed to configure TUN device: %w", err)
+	}
+	p.tun = os.NewFile(uintptr(tunFd), "sandbox-tun")
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.logDenied()
+	}()
+	return nil
+}
+
+// Release the resources of the sandboxed process.
+func (p *sandboxProcess) close() {
+	p.socket.Close()
+	p.child.Close()
+	for _, listener := range p.listeners {
+		listener.Close()
+	}
+	if p.tun != nil {
+		p.tun.Close()
+	}
+	p.wg.Wait()
+}
+
+func parseRights(oob []byte) ([]int, error) {
+	messages, err := unix.ParseSocketControlMessage(oob)
+	if err != nil {
+		return nil, fmt.Errorf("sandbox: invalid control message: %w", err)
+	}
+	var fds []int
+	for i := range messages {
+		rights, err := unix.ParseUnixRights(&messages[i])
+		if err != nil {
+			return nil, fmt.Errorf("sandbox: invalid control message: %w", err)
+		}
+		fds = append(fds, rights...)
+	}
+	return fds, nil
+}
+
+// Forward the connections from the sandbox to the host address.
+func (p *sandboxProcess) serve(listener net.Listener, address string) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			forwardConn(conn, address)
+		}()
+	}
+}
+
+func forwardConn(conn net.Conn, address string) {
+	defer conn.Close()
+	upstream, err := net.Dial("tcp", address)
+	if err != nil {
+		slog.Warn("sandbox: failed to forward connection", "address", address, "error", err)
+		return
+	}
+	defer upstream.Close()
+	go func() {
+		_, _ = io.Copy(upstream, conn)
+		upstream.Close()
+	}()
+	_, _ = io.Copy(conn, upstream)
+}
+
+// Log the packets sent outside the sandbox.
+// These packets are dropped, so the command doesn't reach the network.
+func (p *sandboxProcess) logDenied() {
+	logged := make(map[string]bool)
+	packet := make([]byte, sandboxMaxPacketSize)
+	for {
+		n, err := p.tun.Read(packet)
+		if err != nil {
+			return
+		}
+		protocol, destination, ok := parsePacket(packet[:n])
+		if !ok {
+			continue
+		}
+		key := protocol + " " + destination
+		if logged[key] {
+			continue
+		}
+		logged[key] = true
+		slog.Warn("sandbox: denied network access", "command", p.name,
+			"protocol", protocol, "destination", destination)
+	}
+}
+
+// Get the protocol and the destination of an IPv4 packet.
+// For TCP, only the packets that open connections are considered.
+func parsePacket(packet []byte) (string, string, bool) {
+	const (
+		minHeaderSize   = 20
+		protocolOffset  = 9
+		dstOffset       = 16
+		ipv4Version     = 4
+		tcpFlagsOffset  = 13
+		tcpSynFlag      = 0x02
+		tcpAckFlag      = 0x10
+		portSize        = 2
+		headerWordBytes = 4
+	)
+	if len(packet) < minHeaderSize || packet[0]>>4 != ipv4Version {
+		return "", "", false
+	}
+	headerSize := int(packet[0]&0x0f) * headerWordBytes
+	dst := net.IP(packet[dstOffset : dstOffset+net.IPv4len]).String()
+	payload := packet[min(headerSize, len(packet)):]
+	switch packet[protocolOffset] {
+	case unix.IPPROTO_TCP:
+		if len(payload) <= tcpFlagsOffset {
+			return "", "", false
+		}
+		flags := payload[tcpFlagsOffset]
+		if flags&tcpSynFlag == 0 || flags&tcpAckFlag != 0 {
+			return "", "", false
+		}
+		port := binary.BigEndian.Uint16(payload[portSize:])
+		return "tcp", net.JoinHostPort(dst, strconv.Itoa(int(port))), true
+	case unix.IPPROTO_UDP:
+		if len(payload) < portSize*2 {
+			return "", "", false
+		}
+		port := binary.BigEndian.Uint16(payload[portSize:])
+		return "udp", net.JoinHostPort(dst, strconv.Itoa(int(port))), true
+	case unix.IPPROTO_ICMP:
+		return "icmp", dst, true
+	default:
+		return fmt.Sprintf("ip/%v", packet[protocolOffset]), dst, true
+	}
+}
+
+//
+// Init process side
+//
+
+// Set up the sandbox namespaces in the init process.
+func sandboxInit(fs SandboxFS, command string) error {
+	var listenerFiles []*os.File
+	if ports := os.Getenv(sandboxPortsEnv); ports != "" {
+		for _, port := range strings.Split(ports, ",") {
+			listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", port))
+			if err != nil {
+				return fmt.Errorf("failed to listen: %w", err)
+			}
+			file, err := listener.(*net.TCPListener).File()
+			if err != nil {
+				return fmt.Errorf("failed to get listener file: %w", err)
+			}
+			listenerFiles = append(listenerFiles, file)
+		}
+	}
+	if err := setLinkUp("lo"); err != nil {
+		return err
+	}
+	var fds []int
+	for _, file := range listenerFiles {
+		fds = append(fds, int(file.Fd()))
+	}
+	// Without the TUN device there is no default route, so the network is still unreachable.
+	if tun, err := createTun(); err != nil {
+		fmt.Fprintf(os.Stderr, "sandbox: %v; denied network access won't be logged\n", err)
+	} else {
+		fds = append(fds, tun)
+	}
+	var rights []byte
+	if len(fds) > 0 {
+		rights = unix.UnixRights(fds...)
+	}
+	err := unix.Sendmsg(sandboxSocketFd, []byte{0}, rights, nil, 0)
+	if err != nil {
+		return fmt.Errorf("failed to send file descriptors: %w", err)
+	}
+	unix.Close(sandboxSocketFd)
+
+	switch fs {
+	case SandboxFSHost:
+	case SandboxFSReadOnly:
+		err = setupReadOnlyFS()
+	case SandboxFSPrivate:
+		err = setupPrivateFS(command)
+	default:
+		err = fmt.Errorf("invalid file system: %v", fs)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Drop the capabilities, so the command runs as a regular user.
+	err = unix.Prctl(unix.PR_CAP_AMBIENT, unix.PR_CAP_AMBIENT_CLEAR_ALL, 0, 0, 0)
+	if err != nil {
+		return fmt.Errorf("failed to drop capabilities: %w", err)
+	}
+	return nil
+}
+
+// Set the interface flags to up.
+func setLinkUp(name string) error {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return fmt.Errorf("failed to create socket: %w", err)
+	}
+	defer unix.Close(fd)
+	ifr, err := unix.NewIfreq(name)
+	if err != nil {
+		return err
+	}
+	if err := unix.IoctlIfreq(fd, unix.SIOCGIFFLAGS, ifr); err != nil {
+		return fmt.Errorf("failed to get %v flags: %w", name, err)
+	}
+	ifr.SetUint16(ifr.Uint16() | unix.IFF_UP)
+	if err := unix.IoctlIfreq(fd, unix.SIOCSIFFLAGS, ifr); err != nil {
+		return fmt.Errorf("failed to set %v up: %w", name, err)
+	}
+	return nil
+}
+
+// Create the TUN device and route all packets to it.
+// Return the file descriptor of the device.
+func createTun() (int, error) {
+	tun, err := unix.Open("/dev/net/tun", unix.O_RDWR|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open TUN device: %w", err)
+	}
+	ifr, err := unix.NewIfreq(sandboxTunName)
+	if err != nil {
+		unix.Close(tun)
+		return 0, err
+	}
+	ifr.SetUint16(unix.IFF_TUN | unix.IFF_NO_PI)
+	if err := unix.IoctlIfreq(tun, unix.TUNSETIFF, ifr); err != nil {
+		unix.Close(tun)
+		return 0, fmt.Errorf("failed to create TUN device: %w", err)
+	}
+	if err := setTunAddress(); err != nil {
+		unix.Close(tun)
+		return 0, err
+	}
+	if err := setLinkUp(sandboxTunName); err != nil {
+		unix.Close(tun)
+		return 0, err
+	}
+	iface, err := net.InterfaceByName(sandboxTunName)
+	if err != nil {
+		unix.Close(tun)
+		return 0, err
+	}
+	if err := addDefaultRoute(iface.Index); err != nil {
+		unix.Close(tun)
+		return 0, err
+	}
+	return tun, nil
+}
+
+func setTunAddress() error {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return fmt.Errorf("failed to create socket: %w", err)
+	}
+	defer unix.Close(fd)
+	ifr, err := unix.NewIfreq(sandboxTunName)
+	if err != nil {
+		return err
+	}
+	if err := ifr.SetInet4Addr(sandboxTunAddress); err != nil {
+		return err
+	}
+	if err := unix.IoctlIfreq(fd, unix.SIOCSIFADDR, ifr); err != nil {
+		return fmt.Errorf("failed to set TUN address: %w", err)
+	}
+	return nil
+}
+
+// Add the default IPv4 route to the interface using netlink.
+func addDefaultRoute(ifindex int) error {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_ROUTE)
+	if err != nil {
+		return fmt.Errorf("failed to create netlink socket: %w", err)
+	}
+	defer unix.Close(fd)
+
+	const attrSize = unix.SizeofRtAttr + 4
+	const msgSize = unix.SizeofNlMsghdr + unix.SizeofRtMsg + attrSize
+	msg := make([]byte, msgSize)
+	order := binary.NativeEndian
+	order.PutUint32(msg[0:], msgSize)
+	order.PutUint16(msg[4:], unix.RTM_NEWROUTE)
+	order.PutUint16(msg[6:],
+		unix.NLM_F_REQUEST|unix.NLM_F_ACK|unix.NLM_F_CREATE|unix.NLM_F_EXCL)
+	order.PutUint32(msg[8:], 1) // sequence number
+	rtmsg := msg[unix.SizeofNlMsghdr:]
+	rtmsg[0] = unix.AF_INET       // family
+	rtmsg[4] = unix.RT_TABLE_MAIN // table
+	rtmsg[5] = unix.RTPROT_BOOT   // protocol
+	rtmsg[6] = unix.RT_SCOPE_LINK // scope
+	rtmsg[7] = unix.RTN_UNICAST   // type
+	attr := msg[unix.SizeofNlMsghdr+unix.SizeofRtMsg:]
+	order.PutUint16(attr[0:], attrSize)
+	order.PutUint16(attr[2:], unix.RTA_OIF)
+	order.PutUint32(attr[4:], uint32(ifindex))
+
+	if err := unix.Sendto(fd, msg, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		return fmt.Errorf("failed to send netlink message: %w", err)
+	}
+	resp := make([]byte, os.Getpagesize())
+	n, _, err := unix.Recvfrom(fd, resp, 0)
+	if err != nil {
+		return fmt.Errorf("failed to receive netlink message: %w", err)
+	}
+	messages, err := syscall.ParseNetlinkMessage(resp[:n])
+	if err != nil {
+		return fmt.Errorf("invalid netlink message: %w", err)
+	}
+	for _, m := range messages {
+		if m.Header.Type == unix.NLMSG_ERROR && len(m.Data) >= 4 {
+			if errno := int32(order.Uint32(m.Data)); errno != 0 {
+				return fmt.Errorf("failed to add default route: %w", syscall.Errno(-errno))
+			}
+		}
+	}
+	return nil
+}
+
+// Make the host file system read-only.
+func setupReadOnlyFS() error {
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("failed to make mounts private: %w", err)
+	}
+	attr := unix.MountAttr{Attr_set: unix.MOUNT_ATTR_RDONLY}
+	if err := unix.MountSetattr(unix.AT_FDCWD, "/", unix.AT_RECURSIVE, &attr); err != nil {
+		return fmt.Errorf("failed to make file system read-only: %w", err)
+	}
+	return nil
+}
+
+// Create a new root file system in memory and mount the host directories in read-only mode.
+func setupPrivateFS(command string) error {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	command, err = filepath.Abs(command)
+	if err != nil {
+		return err
+	}
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return fmt.Errorf("failed to make mounts private: %w", err)
+	}
+
+	// Clone the directories before mounting the new root, which might hide them.
+	dirs := append(sandboxSystemDirs, cwd, filepath.Dir(command))
+	trees := make(map[string]int)
+	links := make(map[string]string)
+	defer func() {
+		for _, fd := range trees {
+			unix.Close(fd)
+		}
+	}()
+	for _, dir := range topLevelDirs(dirs) {
+		info, err := os.Lstat(dir)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err := os.Readlink(dir)
+			if err != nil {
+				return err
+			}
+			links[dir] = link
+			continue
+		}
+		flags := unix.OPEN_TREE_CLONE | unix.OPEN_TREE_CLOEXEC | unix.AT_RECURSIVE
+		fd, err := unix.OpenTree(unix.AT_FDCWD, dir, uint(flags))
+		if err != nil {
+			return fmt.Errorf("failed to clone %v: %w", dir, err)
+		}
+		trees[dir] = fd
+		attr := unix.MountAttr{Attr_set: unix.MOUNT_ATTR_RDONLY}
+		err = unix.MountSetattr(fd, "", unix.AT_EMPTY_PATH|unix.AT_RECURSIVE, &attr)
+		if err != nil {
+			return fmt.Errorf("failed to make %v read-only: %w", dir, err)
+		}
+	}
+
+	const dirPermissions = 0755
+	const tmpPermissions = 0777 | os.ModeSticky
+	const oldRootPermissions = 0700
+	root := os.TempDir()
+	if err := unix.Mount("tmpfs", root, "tmpfs", 0, "mode=0755"); err != nil {
+		return fmt.Errorf("failed to mount root: %w", err)
+	}
+	for dir, fd := range trees {
+		target := filepath.Join(root, dir)
+		if err := os.MkdirAll(target, dirPermissions); err != nil {
+			return err
+		}
+		err := unix.MoveMount(fd, "", unix.AT_FDCWD, target, unix.MOVE_MOUNT_F_EMPTY_PATH)
+		if err != nil {
+			return fmt.Errorf("failed to mount %v: %w", dir, err)
+		}
+	}
+	for dir, link := range links {
+		target := filepath.Join(root, dir)
+		if err := os.MkdirAll(filepath.Dir(target), dirPermissions); err != nil {
+			return err
+		}
+		if err := os.Symlink(link, target); err != nil {
+			return err
+		}
+	}
+	// The tmp directory might already exist if the working directory is inside it.
+	if _, ok := trees["/tmp"]; !ok {
+		tmp := filepath.Join(root, "tmp")
+		if err := os.MkdirAll(tmp, tmpPermissions); err != nil {
+			return err
+		}
+		// MkdirAll applies the umask, so set the permissions again.
+		if err := os.Chmod(tmp, tmpPermissions); err != nil {
+			return err
+		}
+	}
+	if shm := filepath.Join(root, "dev/shm"); isDir(shm) {
+		if err := unix.Mount("tmpfs", shm, "tmpfs", 0, ""); err != nil {
+			return fmt.Errorf("failed to mount /dev/shm: %w", err)
+		}
+	}
+
+	oldRoot := filepath.Join(root, ".oldroot")
+	if err := os.Mkdir(oldRoot, oldRootPermissions); err != nil {
+		return err
+	}
+	if err := unix.PivotRoot(root, oldRoot); err != nil {
+		return fmt.Errorf("failed to change root: %w", err)
+	}
+	if err := unix.Unmount("/.oldroot", unix.MNT_DETACH); err != nil {
+		return fmt.Errorf("failed to unmount old root: %w", err)
+	}
+	if err := os.Remove("/.oldroot"); err != nil {
+		return err
+	}
+	return os.Chdir(cwd)
+}
+
+// Remove the directories that are inside other directories in the list.
+func topLevelDirs(dirs []string) []string {
+	sorted := make([]string, len(dirs))
+	for i, dir := range dirs {
+		sorted[i] = filepath.Clean(dir)
+	}
+	sort.Strings(sorted)
+	var result []string
+	for _, dir := range sorted {
+		if len(result) > 0 {
+			last := result[len(result)-1]
+			if dir == last || strings.HasPrefix(dir, strings.TrimSuffix(last, "/")+"/") {
+				continue
+			}
+		}
+		result = append(result, dir)
+	}
+	return result
+}
+
+func isDir(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,110 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build linux
+
+package supervisor
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Environment variable that makes the test binary act as a network client.
+const sandboxHelperEnv = "NONODO_SANDBOX_TEST_HELPER"
+
+func TestMain(m *testing.M) {
+	Init()
+	if address, ok := os.LookupEnv(sandboxHelperEnv); ok {
+		os.Exit(sandboxHelper(address))
+	}
+	os.Exit(m.Run())
+}
+
+// Read a line from the forwarded address and check the network is not reachable.
+func sandboxHelper(address string) int {
+	conn, err := net.Dial("tcp", address)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil || line != "hello\n" {
+		fmt.Fprintln(os.Stderr, "unexpected response", line, err)
+		return 1
+	}
+	const timeout = 500 * time.Millisecond
+	_, err = net.DialTimeout("tcp", "1.1.1.1:80", timeout)
+	if err == nil {
+		fmt.Fprintln(os.Stderr, "network should be unreachable")
+		return 1
+	}
+	return 0
+}
+
+func TestSandboxForwardsAddresses(t *testing.T) {
+	requireSandbox(t)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_, _ = conn.Write([]byte("hello\n"))
+			conn.Close()
+		}
+	}()
+
+	address := listener.Addr().String()
+	w := CommandWorker{
+		Name:    "helper",
+		Command: os.Args[0],
+		Env:     append(os.Environ(), fmt.Sprintf("%v=%v", sandboxHelperEnv, address)),
+		Sandbox: &Sandbox{Forward: []string{address}},
+	}
+	assert.Nil(t, w.Start(context.Background(), make(chan struct{}, 1)))
+}
+
+func TestSandboxReadOnlyFS(t *testing.T) {
+	requireSandbox(t)
+	file := filepath.Join(t.TempDir(), "file")
+	err := runSandboxed(SandboxFSReadOnly, fmt.Sprintf("touch %v", file))
+	assert.NotNil(t, err)
+	assert.NoFileExists(t, file)
+}
+
+func TestSandboxPrivateFS(t *testing.T) {
+	requireSandbox(t)
+	hostFile := filepath.Join(t.TempDir(), "file")
+	require.Nil(t, os.WriteFile(hostFile, nil, 0600))
+	privateFile := fmt.Sprintf("/tmp/nonodo-sandbox-test-%v", time.Now().UnixNano())
+	script := fmt.Sprintf("test ! -e %v && echo hi > %v && test -s %v",
+		hostFile, privateFile, privateFile)
+	assert.Nil(t, runSandboxed(SandboxFSPrivate, script))
+	assert.NoFileExists(t, privateFile)
+}
+
+func runSandboxed(fs SandboxFS, script string) error {
+	w := newShellCommand("test", script)
+	w.Sandbox = &Sandbox{FS: fs}
+	return w.Start(context.Background(), make(chan struct{}, 1))
+}
+
+// Skip the test if the system doesn't support unprivileged user namespaces.
+func requireSandbox(t *testing.T) {
+	if err := runSandboxed(SandboxFSHost, "true"); err != nil {
+		t.Skipf("sandbox not supported: %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build !linux
+
+package supervisor
+
+import (
+	"fmt"
+	"os/exec"
+)
+
+type sandboxProcess struct{}
+
+func (s Sandbox) prepare(name string, cmd *exec.Cmd) (*sandboxProcess, error) {
+	return nil, fmt.Errorf("sandbox: only supported on Linux")
+}
+
+func (p *sandboxProcess) start() error {
+	return nil
+}
+
+func (p *sandboxProcess) close() {}
@@ -0,0 +1,75 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build !windows
+
+package supervisor
+
+import (
+	"log/slog"
+	"syscall"
+	"time"
+)
+
+// Period of the throttle cycle; the command runs for a fraction of each period.
+const throttlePeriod = 100 * time.Millisecond
+
+// Throttles a process group by stopping and resuming it, so it only runs for a fraction of the
+// time.
+type throttle struct {
+	name string
+	pgid int
+	done chan struct{}
+	wait chan struct{}
+}
+
+// Start throttling the process group so it runs the given number of times slower.
+func startThrottle(name string, pgid int, slowdown float64) *throttle {
+	t := &throttle{
+		name: name,
+		pgid: pgid,
+		done: make(chan struct{}),
+		wait: make(chan struct{}),
+	}
+	running := time.Duration(float64(throttlePeriod) / slowdown)
+	go t.run(running, throttlePeriod-running)
+	return t
+}
+
+func (t *throttle) run(running time.Duration, stopped time.Duration) {
+	defer close(t.wait)
+	for {
+		select {
+		case <-t.done:
+			return
+		case <-time.After(running):
+		}
+		if !t.signal(syscall.SIGSTOP) {
+			return
+		}
+		select {
+		case <-t.done:
+		case <-time.After(stopped):
+		}
+		if !t.signal(syscall.SIGCONT) {
+			return
+		}
+	}
+}
+
+// Send the signal to the process group; return false if the group doesn't exist anymore.
+func (t *throttle) signal(sig syscall.Signal) bool {
+	err := syscall.Kill(-t.pgid, sig)
+	if err == syscall.ESRCH {
+		return false
+	} else if err != nil {
+		slog.Warn("command: failed to throttle", "command", t.name, "signal", sig, "error", err)
+	}
+	return true
+}
+
+// Stop throttling the process group, resuming it if it is stopped.
+func (t *throttle) stop() {
+	close(t.done)
+	<-t.wait
+}
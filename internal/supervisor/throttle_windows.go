@@ -0,0 +1,20 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+//go:build windows
+
+package supervisor
+
+import (
+	"log/slog"
+)
+
+type throttle struct{}
+
+// Windows doesn't support stopping processes, so the command runs at full speed.
+func startThrottle(name string, pgid int, slowdown float64) *throttle {
+	slog.Warn("command: slowdown is not supported on Windows", "command", name)
+	return &throttle{}
+}
+
+func (t *throttle) stop() {}
@@ -0,0 +1,218 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Poll interval when checking whether the watched files changed.
+const WatchPollInterval = 500 * time.Millisecond
+
+// This worker runs a command and restarts it when the watched files change.
+// If the command exits, the worker waits for the next change instead of exiting.
+type WatchWorker struct {
+	CommandWorker
+
+	// Glob patterns of the watched files.
+	// The pattern ** matches any number of directories.
+	Patterns []string
+
+	// If set, run this shell script to build the command before starting it.
+	Build string
+
+	// If set, called after the command stops and before it restarts.
+	OnRestart func()
+}
+
+func (w WatchWorker) Start(ctx context.Context, ready chan<- struct{}) error {
+	ready <- struct{}{}
+	snapshot := scanFiles(w.Patterns)
+	for {
+		if w.build(ctx) {
+			err := w.runUntilChange(ctx, &snapshot)
+			if err != nil {
+				return err
+			}
+		} else {
+			slog.Warn("watch: build failed; waiting for changes", "command", w)
+			if err := waitChange(ctx, w.Patterns, &snapshot, nil); err != nil {
+				return err
+			}
+		}
+		slog.Info("watch: files changed; restarting", "command", w)
+		if w.OnRestart != nil {
+			w.OnRestart()
+		}
+	}
+}
+
+// Run the build script, if there is one.
+// Return whether the build succeeded.
+func (w WatchWorker) build(ctx context.Context) bool {
+	if w.Build == "" {
+		return true
+	}
+	slog.Info("watch: building", "command", w, "script", w.Build)
+	build := newShellCommand("build", w.Build)
+	err := build.Start(ctx, make(chan struct{}, 1))
+	return err == nil
+}
+
+// Run the command until the watched files change.
+func (w WatchWorker) runUntilChange(ctx context.Context, snapshot *map[string]fileStat) error {
+	cmdCtx, cmdCancel := context.WithCancel(ctx)
+	defer cmdCancel()
+	exited := make(chan struct{})
+	go func() {
+		defer close(exited)
+		err := w.CommandWorker.Start(cmdCtx, make(chan struct{}, 1))
+		if cmdCtx.Err() == nil {
+			slog.Warn("watch: command exited; waiting for changes", "command", w, "error", err)
+		}
+	}()
+	err := waitChange(ctx, w.Patterns, snapshot, exited)
+	cmdCancel()
+	<-exited
+	return err
+}
+
+// Wait until the watched files change.
+// Stop polling after the exited channel is closed.
+func waitChange(
+	ctx context.Context,
+	patterns []string,
+	snapshot *map[string]fileStat,
+	exited <-chan struct{},
+) error {
+	ticker := time.NewTicker(WatchPollInterval)
+	defer ticker.Stop()
+	changed := false
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-exited:
+			exited = nil
+		case <-ticker.C:
+			current := scanFiles(patterns)
+			if !equalSnapshots(*snapshot, current) {
+				*snapshot = current
+				changed = true
+			} else if changed {
+				// wait until the files stop changing
+				return nil
+			}
+		}
+	}
+}
+
+// Modification time and size of a file.
+type fileStat struct {
+	modTime time.Time
+	size    int64
+}
+
+// Get the stats of the files matching the patterns.
+// Hidden directories are skipped.
+func scanFiles(patterns []string) map[string]fileStat {
+	files := make(map[string]fileStat)
+	for _, pattern := range patterns {
+		pattern = filepath.ToSlash(filepath.Clean(pattern))
+		root := globRoot(pattern)
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return nil
+			}
+			if d.IsDir() {
+				if path != root && strings.HasPrefix(d.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !matchGlob(pattern, filepath.ToSlash(path)) {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return nil
+			}
+			files[path] = fileStat{info.ModTime(), info.Size()}
+			return nil
+		})
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			slog.Warn("watch: failed to scan files", "pattern", pattern, "error", err)
+		}
+	}
+	return files
+}
+
+func equalSnapshots(a map[string]fileStat, b map[string]fileStat) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for path, stat := range a {
+		other, ok := b[path]
+		if !ok || !stat.modTime.Equal(other.modTime) || stat.size != other.size {
+			return false
+		}
+	}
+	return true
+}
+
+// Get the directory where the walk should start for the given pattern.
+func globRoot(pattern string) string {
+	segments := strings.Split(pattern, "/")
+	var root []string
+	for _, segment := range segments[:len(segments)-1] {
+		if strings.ContainsAny(segment, "*?[\\") {
+			break
+		}
+		root = append(root, segment)
+	}
+	if len(root) == 0 {
+		return "."
+	}
+	if root[0] == "" {
+		root[0] = "/"
+	}
+	return filepath.FromSlash(filepath.Join(root...))
+}
+
+// Check whether the slash-separated name matches the pattern.
+// The segment ** matches any number of directories, and the other segments follow the syntax
+// from filepath.Match.
+func matchGlob(pattern string, name string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern []string, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		ok, err := filepath.Match(pattern[0], name[0])
+		if err != nil || !ok {
+			return false
+		}
+		pattern = pattern[1:]
+		name = name[1:]
+	}
+	return len(name) == 0
+}
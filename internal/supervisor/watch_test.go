@@ -0,0 +1,70 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package supervisor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchGlob(t *testing.T) {
+	cases := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"*.go", "main.go", true},
+		{"*.go", "src/main.go", false},
+		{"src/*.go", "src/main.go", true},
+		{"**/*.go", "main.go", true},
+		{"**/*.go", "a/b/c/main.go", true},
+		{"**/*.go", "a/b/c/main.py", false},
+		{"src/**", "src/a/b", true},
+		{"src/**", "lib/a", false},
+		{"src/**/test/*.py", "src/test/a.py", true},
+		{"src/**/test/*.py", "src/x/y/test/a.py", true},
+		{"src/**/test/*.py", "src/x/y/a.py", false},
+		{"/abs/**/*.rs", "/abs/x/main.rs", true},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.match, matchGlob(c.pattern, c.name), "%v %v", c.pattern, c.name)
+	}
+}
+
+func TestGlobRoot(t *testing.T) {
+	assert.Equal(t, ".", globRoot("*.go"))
+	assert.Equal(t, ".", globRoot("**/*.go"))
+	assert.Equal(t, filepath.FromSlash("src/app"), globRoot("src/app/**/*.go"))
+	assert.Equal(t, filepath.FromSlash("/abs"), globRoot("/abs/*.go"))
+}
+
+func TestScanFilesDetectsChanges(t *testing.T) {
+	dir := t.TempDir()
+	pattern := filepath.ToSlash(filepath.Join(dir, "**", "*.txt"))
+	writeFile := func(name string, data string) {
+		path := filepath.Join(dir, name)
+		assert.Nil(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.Nil(t, os.WriteFile(path, []byte(data), 0644))
+	}
+
+	writeFile("a.txt", "a")
+	writeFile("sub/b.txt", "b")
+	writeFile("sub/c.bin", "c")
+	writeFile(".hidden/d.txt", "d")
+	snapshot := scanFiles([]string{pattern})
+	assert.Len(t, snapshot, 2)
+	assert.True(t, equalSnapshots(snapshot, scanFiles([]string{pattern})))
+
+	writeFile("sub/b.txt", "bb")
+	assert.False(t, equalSnapshots(snapshot, scanFiles([]string{pattern})))
+
+	snapshot = scanFiles([]string{pattern})
+	future := time.Now().Add(time.Hour)
+	assert.Nil(t, os.Chtimes(filepath.Join(dir, "a.txt"), future, future))
+	assert.False(t, equalSnapshots(snapshot, scanFiles([]string{pattern})))
+}
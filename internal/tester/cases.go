@@ -0,0 +1,199 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package tester
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/devnet"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/payload"
+	"gopkg.in/yaml.v3"
+)
+
+// Kinds of test inputs.
+const (
+	InputKindAdvance = "advance"
+	InputKindInspect = "inspect"
+)
+
+// File with the test cases.
+type Suite struct {
+	Cases []Case `yaml:"cases"`
+}
+
+// Test case, which runs against a fresh instance of the application.
+type Case struct {
+	Name   string  `yaml:"name"`
+	Inputs []Input `yaml:"inputs"`
+}
+
+// Input sent to the application, followed by the expected result.
+type Input struct {
+	Kind    string          `yaml:"kind"`
+	Sender  string          `yaml:"sender"`
+	Payload payload.Payload `yaml:"payload"`
+	Expect  Expect          `yaml:"expect"`
+}
+
+// Expected result of an input.
+// Only the fields that are set are checked.
+type Expect struct {
+	Status    string                 `yaml:"status"`
+	Vouchers  *OutputsExpect         `yaml:"vouchers"`
+	Notices   *OutputsExpect         `yaml:"notices"`
+	Reports   *OutputsExpect         `yaml:"reports"`
+	Exception *PayloadExpect         `yaml:"exception"`
+	status    model.CompletionStatus `yaml:"-"`
+}
+
+// Expected outputs of a given type.
+type OutputsExpect struct {
+	Count *int           `yaml:"count"`
+	Items []OutputExpect `yaml:"items"`
+}
+
+// Expected output, the items are matched with the outputs in order.
+type OutputExpect struct {
+	PayloadExpect `yaml:",inline"`
+	Destination   string      `yaml:"destination"`
+	Call          *CallExpect `yaml:"call"`
+}
+
+// Expected payload.
+type PayloadExpect struct {
+	Payload *payload.Payload `yaml:"payload"`
+	Regex   string           `yaml:"regex"`
+	JSON    []JSONExpect     `yaml:"json"`
+	regex   *regexp.Regexp   `yaml:"-"`
+}
+
+// Expected value in the given path of a JSON payload.
+// The path has the format $.field.array[0].
+type JSONExpect struct {
+	Path  string `yaml:"path"`
+	Value any    `yaml:"value"`
+}
+
+// Expected voucher call, decoded using the function signature.
+// Each argument is compared with the decoded value formatted as a string.
+type CallExpect struct {
+	Signature string   `yaml:"signature"`
+	Args      []string `yaml:"args"`
+}
+
+// Load the test suite from the given file.
+func LoadSuite(path string) (*Suite, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("tester: %w", err)
+	}
+	return ParseSuite(data)
+}
+
+// Parse the test suite and validate it.
+func ParseSuite(data []byte) (*Suite, error) {
+	var suite Suite
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&suite); err != nil {
+		return nil, fmt.Errorf("tester: parse: %w", err)
+	}
+	for i := range suite.Cases {
+		c := &suite.Cases[i]
+		if c.Name == "" {
+			c.Name = fmt.Sprintf("case %v", i)
+		}
+		for j := range c.Inputs {
+			if err := c.Inputs[j].validate(); err != nil {
+				return nil, fmt.Errorf("tester: %v: input %v: %w", c.Name, j, err)
+			}
+		}
+	}
+	return &suite, nil
+}
+
+func (i *Input) validate() error {
+	switch i.Kind {
+	case "":
+		i.Kind = InputKindAdvance
+	case InputKindAdvance, InputKindInspect:
+	default:
+		return fmt.Errorf("invalid kind: %v", i.Kind)
+	}
+	if i.Sender == "" {
+		i.Sender = devnet.SenderAddress
+	}
+	if !common.IsHexAddress(i.Sender) {
+		return fmt.Errorf("invalid sender: %v", i.Sender)
+	}
+	if i.Kind == InputKindInspect && (i.Expect.Vouchers != nil || i.Expect.Notices != nil) {
+		return fmt.Errorf("inspect inputs don't produce vouchers or notices")
+	}
+	return i.Expect.validate()
+}
+
+func (e *Expect) validate() error {
+	if e.Status != "" {
+		status, ok := completionStatuses[strings.ToLower(e.Status)]
+		if !ok {
+			return fmt.Errorf("invalid status: %v", e.Status)
+		}
+		e.status = status
+	}
+	for _, outputs := range []*OutputsExpect{e.Vouchers, e.Notices, e.Reports} {
+		if outputs == nil {
+			continue
+		}
+		for i := range outputs.Items {
+			if err := outputs.Items[i].validate(); err != nil {
+				return err
+			}
+		}
+	}
+	if e.Exception != nil {
+		return e.Exception.validate()
+	}
+	return nil
+}
+
+func (o *OutputExpect) validate() error {
+	if o.Destination != "" && !common.IsHexAddress(o.Destination) {
+		return fmt.Errorf("invalid destination: %v", o.Destination)
+	}
+	if o.Call != nil {
+		if _, err := parseCall(o.Call.Signature); err != nil {
+			return err
+		}
+	}
+	return o.PayloadExpect.validate()
+}
+
+func (p *PayloadExpect) validate() error {
+	if p.Regex != "" {
+		regex, err := regexp.Compile(p.Regex)
+		if err != nil {
+			return fmt.Errorf("invalid regex: %w", err)
+		}
+		p.regex = regex
+	}
+	for _, j := range p.JSON {
+		if _, err := payload.ParseJSONPath(j.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var completionStatuses = map[string]model.CompletionStatus{
+	"accepted":       model.CompletionStatusAccepted,
+	"rejected":       model.CompletionStatusRejected,
+	"exception":      model.CompletionStatusException,
+	"machine_halted": model.CompletionStatusMachineHalted,
+}
@@ -0,0 +1,307 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package tester
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/readerclient"
+)
+
+// Number of inputs fetched in each request to the remote reader.
+const DiffPageSize = 100
+
+// Differences between the local and the remote result of an input.
+type InputDiff struct {
+	Index       int
+	Differences []string
+}
+
+// Fetch the processed advance inputs and their outputs from the remote reader GraphQL endpoint.
+// Stop at the first unprocessed input, because the remote node didn't produce its outputs yet.
+// If limit is greater than zero, fetch at most this number of inputs.
+// The exception payloads are nil if the remote reader doesn't expose them.
+func FetchHistory(ctx context.Context, endpoint string, limit int) ([]model.AdvanceInput, error) {
+	client := graphql.NewClient(endpoint, http.DefaultClient)
+	var inputs []model.AdvanceInput
+	exceptions := true
+	after := ""
+	for {
+		response, err := readerclient.History(ctx, client, DiffPageSize, DiffPageSize, after)
+		if err != nil {
+			return nil, fmt.Errorf("tester: failed to fetch inputs: %w", err)
+		}
+		for _, edge := range response.Inputs.Edges {
+			node := edge.Node
+			if convertRemoteStatus(node.Status) == model.CompletionStatusUnprocessed {
+				return inputs, nil
+			}
+			if err := fetchOutputs(ctx, client, &node); err != nil {
+				return nil, fmt.Errorf("tester: input %v: %w", node.Index, err)
+			}
+			input, err := convertRemoteInput(node)
+			if err != nil {
+				return nil, fmt.Errorf("tester: input %v: %w", node.Index, err)
+			}
+			if input.Status == model.CompletionStatusException && exceptions {
+				input.Exception, exceptions, err = fetchException(ctx, client, node.Index)
+				if err != nil {
+					return nil, fmt.Errorf("tester: input %v: %w", node.Index, err)
+				}
+			}
+			inputs = append(inputs, input)
+			if limit > 0 && len(inputs) == limit {
+				return inputs, nil
+			}
+		}
+		pageInfo := response.Inputs.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == "" {
+			return inputs, nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
+
+// Fetch the remaining pages of the outputs of the input.
+func fetchOutputs(
+	ctx context.Context,
+	client graphql.Client,
+	node *readerclient.HistoryInputsInputConnectionEdgesInputEdgeNodeInput,
+) error {
+	for page := node.Vouchers.PageInfo; page.HasNextPage && page.EndCursor != ""; {
+		response, err := readerclient.InputVouchers(ctx, client, node.Index, DiffPageSize,
+			page.EndCursor)
+		if err != nil {
+			return fmt.Errorf("failed to fetch vouchers: %w", err)
+		}
+		node.Vouchers.Edges = append(node.Vouchers.Edges, response.Input.Vouchers.Edges...)
+		page = response.Input.Vouchers.PageInfo
+	}
+	for page := node.Notices.PageInfo; page.HasNextPage && page.EndCursor != ""; {
+		response, err := readerclient.InputNotices(ctx, client, node.Index, DiffPageSize,
+			page.EndCursor)
+		if err != nil {
+			return fmt.Errorf("failed to fetch notices: %w", err)
+		}
+		node.Notices.Edges = append(node.Notices.Edges, response.Input.Notices.Edges...)
+		page = response.Input.Notices.PageInfo
+	}
+	for page := node.Reports.PageInfo; page.HasNextPage && page.EndCursor != ""; {
+		response, err := readerclient.InputReports(ctx, client, node.Index, DiffPageSize,
+			page.EndCursor)
+		if err != nil {
+			return fmt.Errorf("failed to fetch reports: %w", err)
+		}
+		node.Reports.Edges = append(node.Reports.Edges, response.Input.Reports.Edges...)
+		page = response.Input.Reports.PageInfo
+	}
+	return nil
+}
+
+// Fetch the exception payload of the input.
+// Only nonodo readers expose the payload, so return false if the reader rejects the query.
+func fetchException(
+	ctx context.Context,
+	client graphql.Client,
+	index int,
+) ([]byte, bool, error) {
+	response, err := readerclient.InputException(ctx, client, index)
+	// the servers answer unknown fields with a validation error that names the field
+	if err != nil && strings.Contains(err.Error(), "field") &&
+		strings.Contains(err.Error(), "exception") {
+		slog.Warn("tester: the remote reader doesn't expose the exception payloads, "+
+			"so they aren't compared", "error", err)
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to fetch exception: %w", err)
+	}
+	exception, err := hexutil.Decode(response.Input.Exception)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid exception payload: %w", err)
+	}
+	return exception, true, nil
+}
+
+// Send the inputs to a new instance of nonodo and compare the results with the inputs' results.
+// Return the inputs whose status or outputs differ.
+func (t Tester) Diff(ctx context.Context, inputs []model.AdvanceInput) ([]InputDiff, error) {
+	m, exited, stop, err := t.start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stop()
+
+	var diffs []InputDiff
+	for i, remote := range inputs {
+		m.AddAdvanceInput(remote.MsgSender, remote.Payload, remote.BlockNumber, remote.Timestamp)
+		var local model.AdvanceInput
+		err := t.waitProcessed(ctx, exited, func() bool {
+			local, _ = m.GetAdvanceInput(i)
+			return local.Status != model.CompletionStatusUnprocessed
+		})
+		if err != nil {
+			return diffs, fmt.Errorf("input %v: %w", remote.Index, err)
+		}
+		differences := diffAdvance(remote, local)
+		if len(differences) > 0 {
+			slog.Warn("tester: input differs", "index", remote.Index, "differences", differences)
+			diffs = append(diffs, InputDiff{Index: remote.Index, Differences: differences})
+		}
+	}
+	return diffs, nil
+}
+
+// Compare the status, the outputs, and the exception of the remote input with the local one.
+func diffAdvance(remote model.AdvanceInput, local model.AdvanceInput) []string {
+	var differences []string
+	if remote.Status != local.Status {
+		differences = append(differences, fmt.Sprintf("status: remote %v; local %v",
+			statusName(remote.Status), statusName(local.Status)))
+	}
+	differences = append(differences, diffOutputs("voucher",
+		formatOutputs(remote.Vouchers, formatVoucher),
+		formatOutputs(local.Vouchers, formatVoucher))...)
+	differences = append(differences, diffOutputs("notice",
+		formatOutputs(remote.Notices, func(n model.Notice) string {
+			return hexutil.Encode(n.Payload)
+		}),
+		formatOutputs(local.Notices, func(n model.Notice) string {
+			return hexutil.Encode(n.Payload)
+		}))...)
+	differences = append(differences, diffOutputs("report",
+		formatOutputs(remote.Reports, func(r model.Report) string {
+			return hexutil.Encode(r.Payload)
+		}),
+		formatOutputs(local.Reports, func(r model.Report) string {
+			return hexutil.Encode(r.Payload)
+		}))...)
+	// the remote exception is nil if the remote reader doesn't expose it
+	if remote.Exception != nil && !bytes.Equal(remote.Exception, local.Exception) {
+		differences = append(differences, fmt.Sprintf("exception: remote %v; local %v",
+			hexutil.Encode(remote.Exception), hexutil.Encode(local.Exception)))
+	}
+	return differences
+}
+
+// Compare the outputs of the same kind one by one.
+func diffOutputs(kind string, remote []string, local []string) []string {
+	var differences []string
+	if len(remote) != len(local) {
+		differences = append(differences, fmt.Sprintf("%v count: remote %v; local %v",
+			kind, len(remote), len(local)))
+	}
+	for i := 0; i < len(remote) && i < len(local); i++ {
+		if remote[i] != local[i] {
+			differences = append(differences, fmt.Sprintf("%v %v: remote %v; local %v",
+				kind, i, remote[i], local[i]))
+		}
+	}
+	return differences
+}
+
+// Format each output as a string.
+func formatOutputs[T any](outputs []T, format func(T) string) []string {
+	formatted := make([]string, len(outputs))
+	for i, output := range outputs {
+		formatted[i] = format(output)
+	}
+	return formatted
+}
+
+func formatVoucher(voucher model.Voucher) string {
+	return fmt.Sprintf("%v:%v", voucher.Destination.Hex(), hexutil.Encode(voucher.Payload))
+}
+
+// Convert the input fetched from the remote reader.
+func convertRemoteInput(
+	node readerclient.HistoryInputsInputConnectionEdgesInputEdgeNodeInput,
+) (model.AdvanceInput, error) {
+	input := model.AdvanceInput{
+		Index:     node.Index,
+		Status:    convertRemoteStatus(node.Status),
+		MsgSender: common.HexToAddress(node.MsgSender),
+	}
+	var err error
+	input.Payload, err = hexutil.Decode(node.Payload)
+	if err != nil {
+		return input, fmt.Errorf("invalid payload: %w", err)
+	}
+	input.BlockNumber, err = strconv.ParseUint(node.BlockNumber, 10, 64)
+	if err != nil {
+		return input, fmt.Errorf("invalid block number: %w", err)
+	}
+	timestamp, err := strconv.ParseInt(node.Timestamp, 10, 64)
+	if err != nil {
+		return input, fmt.Errorf("invalid timestamp: %w", err)
+	}
+	input.Timestamp = time.Unix(timestamp, 0)
+	for i, edge := range node.Vouchers.Edges {
+		payload, err := hexutil.Decode(edge.Node.Payload)
+		if err != nil {
+			return input, fmt.Errorf("invalid voucher payload: %w", err)
+		}
+		input.Vouchers = append(input.Vouchers, model.Voucher{
+			Index:       i,
+			InputIndex:  node.Index,
+			Destination: common.HexToAddress(edge.Node.Destination),
+			Payload:     payload,
+		})
+	}
+	for i, edge := range node.Notices.Edges {
+		payload, err := hexutil.Decode(edge.Node.Payload)
+		if err != nil {
+			return input, fmt.Errorf("invalid notice payload: %w", err)
+		}
+		input.Notices = append(input.Notices, model.Notice{
+			Index:      i,
+			InputIndex: node.Index,
+			Payload:    payload,
+		})
+	}
+	for i, edge := range node.Reports.Edges {
+		payload, err := hexutil.Decode(edge.Node.Payload)
+		if err != nil {
+			return input, fmt.Errorf("invalid report payload: %w", err)
+		}
+		input.Reports = append(input.Reports, model.Report{
+			Index:      i,
+			InputIndex: node.Index,
+			Payload:    payload,
+		})
+	}
+	return input, nil
+}
+
+// Convert the remote status; the limit statuses of the rollups node halt the machine.
+func convertRemoteStatus(status readerclient.CompletionStatus) model.CompletionStatus {
+	switch status {
+	case readerclient.CompletionStatusAccepted:
+		return model.CompletionStatusAccepted
+	case readerclient.CompletionStatusRejected:
+		return model.CompletionStatusRejected
+	case readerclient.CompletionStatusException:
+		return model.CompletionStatusException
+	case readerclient.CompletionStatusMachineHalted,
+		readerclient.CompletionStatusCycleLimitExceeded,
+		readerclient.CompletionStatusTimeLimitExceeded,
+		readerclient.CompletionStatusPayloadLengthLimitExceeded:
+		return model.CompletionStatusMachineHalted
+	case readerclient.CompletionStatusUnprocessed:
+		return model.CompletionStatusUnprocessed
+	default:
+		return model.CompletionStatusUnprocessed
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package tester
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/devnet"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/nonodo"
+	"github.com/gligneul/nonodo/internal/reader"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Port used by nonodo in the diff test.
+const diffTestPort = 18545
+
+// Start a remote reader with the inputs processed like the echo application, except that the
+// second input has another notice and the third input was rejected.
+func startRemoteReader(t *testing.T) string {
+	sender := common.HexToAddress(devnet.SenderAddress)
+	m := model.NewNonodoModel()
+	payloads := []string{"first", "second", "third", "unprocessed"}
+	for i, payload := range payloads {
+		m.AddAdvanceInput(sender, []byte(payload), uint64(i), time.Unix(int64(i), 0))
+	}
+	accepted := true
+	for i := 0; i < len(payloads)-1; i++ {
+		input := m.FinishAndGetNext(accepted).(model.AdvanceInput)
+		_, err := m.AddVoucher(sender, input.Payload)
+		require.Nil(t, err)
+		notice := input.Payload
+		if i == 1 {
+			notice = []byte("other")
+		}
+		_, err = m.AddNotice(notice)
+		require.Nil(t, err)
+		require.Nil(t, m.AddReport(input.Payload))
+		accepted = i != 2
+	}
+	m.FinishAndGetNext(accepted)
+
+	e := echo.New()
+	reader.Register(e, m)
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+	return server.URL + "/graphql"
+}
+
+func TestDiff(t *testing.T) {
+	ctx := context.Background()
+	inputs, err := FetchHistory(ctx, startRemoteReader(t), 0)
+	require.Nil(t, err)
+	require.Len(t, inputs, 3)
+	assert.Equal(t, []byte("second"), inputs[1].Payload)
+	assert.Equal(t, uint64(1), inputs[1].BlockNumber)
+	assert.Equal(t, int64(1), inputs[1].Timestamp.Unix())
+	assert.Equal(t, model.CompletionStatusRejected, inputs[2].Status)
+	require.Len(t, inputs[0].Vouchers, 1)
+	assert.Equal(t, common.HexToAddress(devnet.SenderAddress), inputs[0].Vouchers[0].Destination)
+
+	limited, err := FetchHistory(ctx, startRemoteReader(t), 1)
+	require.Nil(t, err)
+	assert.Len(t, limited, 1)
+
+	opts := nonodo.NewNonodoOpts()
+	opts.EnableEcho = true
+	opts.HttpPort = diffTestPort
+	diffs, err := Tester{Opts: opts}.Diff(ctx, inputs)
+	require.Nil(t, err)
+	require.Len(t, diffs, 2)
+	assert.Equal(t, 1, diffs[0].Index)
+	assert.Equal(t, []string{"notice 0: remote 0x6f74686572; local 0x7365636f6e64"},
+		diffs[0].Differences)
+	assert.Equal(t, 2, diffs[1].Index)
+	assert.Equal(t, []string{
+		"status: remote rejected; local accepted",
+		"voucher count: remote 0; local 1",
+		"notice count: remote 0; local 1",
+	}, diffs[1].Differences)
+}
+
+func TestDiffOutputs(t *testing.T) {
+	assert.Empty(t, diffOutputs("report", []string{"a"}, []string{"a"}))
+	assert.Equal(t, []string{"report count: remote 2; local 1", "report 0: remote a; local b"},
+		diffOutputs("report", []string{"a", "c"}, []string{"b"}))
+}
+
+func TestFetchHistoryPagesOutputs(t *testing.T) {
+	sender := common.HexToAddress(devnet.SenderAddress)
+	m := model.NewNonodoModel()
+	m.AddAdvanceInput(sender, []byte("input"), 0, time.Unix(0, 0))
+	m.FinishAndGetNext(true)
+	reports := 2*DiffPageSize + 1
+	for i := 0; i < reports; i++ {
+		require.Nil(t, m.AddReport([]byte(fmt.Sprint(i))))
+	}
+	require.Nil(t, m.RegisterException([]byte("exception")))
+	e := echo.New()
+	reader.Register(e, m)
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	inputs, err := FetchHistory(context.Background(), server.URL+"/graphql", 0)
+	require.Nil(t, err)
+	require.Len(t, inputs, 1)
+	assert.Equal(t, model.CompletionStatusException, inputs[0].Status)
+	assert.Equal(t, []byte("exception"), inputs[0].Exception)
+	require.Len(t, inputs[0].Reports, reports)
+	for i, report := range inputs[0].Reports {
+		assert.Equal(t, []byte(fmt.Sprint(i)), report.Payload)
+	}
+}
+
+func TestFetchExceptionFromOtherReaders(t *testing.T) {
+	// the reader of the rollups node doesn't have the exception field
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"errors":[{"message":` +
+			`"Cannot query field \"exception\" on type \"Input\"."}]}`))
+	}))
+	defer server.Close()
+	client := graphql.NewClient(server.URL, http.DefaultClient)
+	exception, ok, err := fetchException(context.Background(), client, 0)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, exception)
+}
+
+func TestDiffAdvanceComparesExceptions(t *testing.T) {
+	remote := model.AdvanceInput{
+		Status:    model.CompletionStatusException,
+		Exception: []byte{1},
+	}
+	local := remote
+	assert.Empty(t, diffAdvance(remote, local))
+	local.Exception = []byte{2}
+	assert.Equal(t, []string{"exception: remote 0x01; local 0x02"}, diffAdvance(remote, local))
+
+	// the exception isn't compared if the remote reader doesn't expose it
+	remote.Exception = nil
+	assert.Empty(t, diffAdvance(remote, local))
+}
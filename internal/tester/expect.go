@@ -0,0 +1,234 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package tester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/payload"
+)
+
+// Output produced by the application, which can be a voucher, a notice, or a report.
+type output struct {
+	destination *common.Address
+	payload     []byte
+}
+
+// Check the advance input against the expectation.
+// Return the list of failures.
+func (e *Expect) checkAdvance(input model.AdvanceInput) []string {
+	var failures []string
+	failures = append(failures, e.checkStatus(input.Status)...)
+	if e.Vouchers != nil {
+		outputs := make([]output, len(input.Vouchers))
+		for i, voucher := range input.Vouchers {
+			destination := voucher.Destination
+			outputs[i] = output{&destination, voucher.Payload}
+		}
+		failures = append(failures, e.Vouchers.check("voucher", outputs)...)
+	}
+	if e.Notices != nil {
+		outputs := make([]output, len(input.Notices))
+		for i, notice := range input.Notices {
+			outputs[i] = output{nil, notice.Payload}
+		}
+		failures = append(failures, e.Notices.check("notice", outputs)...)
+	}
+	failures = append(failures, e.checkReports(input.Reports)...)
+	failures = append(failures, e.checkException(input.Exception)...)
+	return failures
+}
+
+// Check the inspect input against the expectation.
+// Return the list of failures.
+func (e *Expect) checkInspect(input model.InspectInput) []string {
+	var failures []string
+	failures = append(failures, e.checkStatus(input.Status)...)
+	failures = append(failures, e.checkReports(input.Reports)...)
+	failures = append(failures, e.checkException(input.Exception)...)
+	return failures
+}
+
+func (e *Expect) checkStatus(status model.CompletionStatus) []string {
+	if e.Status == "" || e.status == status {
+		return nil
+	}
+	return []string{fmt.Sprintf("expected status %v; got %v", e.Status, statusName(status))}
+}
+
+func (e *Expect) checkReports(reports []model.Report) []string {
+	if e.Reports == nil {
+		return nil
+	}
+	outputs := make([]output, len(reports))
+	for i, report := range reports {
+		outputs[i] = output{nil, report.Payload}
+	}
+	return e.Reports.check("report", outputs)
+}
+
+func (e *Expect) checkException(exception []byte) []string {
+	if e.Exception == nil {
+		return nil
+	}
+	return e.Exception.check("exception", exception)
+}
+
+func (o *OutputsExpect) check(name string, outputs []output) []string {
+	var failures []string
+	if o.Count != nil && *o.Count != len(outputs) {
+		failures = append(failures,
+			fmt.Sprintf("expected %v %v(s); got %v", *o.Count, name, len(outputs)))
+	}
+	for i, item := range o.Items {
+		itemName := fmt.Sprintf("%v %v", name, i)
+		if i >= len(outputs) {
+			failures = append(failures, fmt.Sprintf("%v: missing", itemName))
+			continue
+		}
+		failures = append(failures, item.check(itemName, outputs[i])...)
+	}
+	return failures
+}
+
+func (o *OutputExpect) check(name string, out output) []string {
+	var failures []string
+	if o.Destination != "" {
+		expected := common.HexToAddress(o.Destination)
+		if out.destination == nil || *out.destination != expected {
+			failures = append(failures, fmt.Sprintf("%v: expected destination %v; got %v",
+				name, expected, out.destination))
+		}
+	}
+	if o.Call != nil {
+		failures = append(failures, o.Call.check(name, out.payload)...)
+	}
+	failures = append(failures, o.PayloadExpect.check(name, out.payload)...)
+	return failures
+}
+
+func (p *PayloadExpect) check(name string, payload []byte) []string {
+	var failures []string
+	if p.Payload != nil && !bytes.Equal(*p.Payload, payload) {
+		failures = append(failures, fmt.Sprintf("%v: expected payload %v; got %v",
+			name, hexutil.Encode(*p.Payload), hexutil.Encode(payload)))
+	}
+	if p.regex != nil && !p.regex.Match(payload) {
+		failures = append(failures, fmt.Sprintf("%v: payload %q doesn't match %q",
+			name, payload, p.Regex))
+	}
+	if len(p.JSON) > 0 {
+		var document any
+		if err := json.Unmarshal(payload, &document); err != nil {
+			failures = append(failures, fmt.Sprintf("%v: payload isn't JSON: %v", name, err))
+			return failures
+		}
+		for _, j := range p.JSON {
+			failures = append(failures, j.check(name, document)...)
+		}
+	}
+	return failures
+}
+
+func (j *JSONExpect) check(name string, document any) []string {
+	steps, err := payload.ParseJSONPath(j.Path)
+	if err != nil {
+		return []string{fmt.Sprintf("%v: %v", name, err)}
+	}
+	value, ok := payload.LookupJSONPath(document, steps)
+	if !ok {
+		return []string{fmt.Sprintf("%v: path %v not found", name, j.Path)}
+	}
+	expected, err := payload.NormalizeJSON(j.Value)
+	if err != nil {
+		return []string{fmt.Sprintf("%v: %v", name, err)}
+	}
+	if !reflect.DeepEqual(expected, value) {
+		return []string{fmt.Sprintf("%v: expected %v at %v; got %v", name, expected, j.Path, value)}
+	}
+	return nil
+}
+
+func (c *CallExpect) check(name string, payload []byte) []string {
+	method, err := parseCall(c.Signature)
+	if err != nil {
+		return []string{fmt.Sprintf("%v: %v", name, err)}
+	}
+	if len(payload) < len(method.ID) || !bytes.Equal(payload[:len(method.ID)], method.ID) {
+		return []string{fmt.Sprintf("%v: payload isn't a call to %v", name, method.Sig)}
+	}
+	values, err := method.Inputs.Unpack(payload[len(method.ID):])
+	if err != nil {
+		return []string{fmt.Sprintf("%v: failed to decode call to %v: %v", name, method.Sig, err)}
+	}
+	if len(c.Args) != len(values) {
+		return []string{fmt.Sprintf("%v: expected %v argument(s) for %v",
+			name, len(values), method.Sig)}
+	}
+	var failures []string
+	for i, value := range values {
+		actual := formatABIValue(value)
+		if !strings.EqualFold(c.Args[i], actual) {
+			failures = append(failures, fmt.Sprintf("%v: expected argument %v of %v to be %v; got %v",
+				name, i, method.Sig, c.Args[i], actual))
+		}
+	}
+	return failures
+}
+
+// Parse a function signature, such as transfer(address,uint256), into an ABI method.
+func parseCall(signature string) (abi.Method, error) {
+	selector, err := abi.ParseSelector(signature)
+	if err != nil {
+		return abi.Method{}, fmt.Errorf("invalid call signature: %w", err)
+	}
+	inputs := make(abi.Arguments, len(selector.Inputs))
+	for i, arg := range selector.Inputs {
+		typ, err := abi.NewType(arg.Type, arg.InternalType, arg.Components)
+		if err != nil {
+			return abi.Method{}, fmt.Errorf("invalid call signature: %w", err)
+		}
+		inputs[i] = abi.Argument{Name: fmt.Sprintf("arg%v", i), Type: typ}
+	}
+	return abi.NewMethod(selector.Name, selector.Name, abi.Function, "", false, false,
+		inputs, nil), nil
+}
+
+// Format the value decoded by the ABI as a string.
+func formatABIValue(value any) string {
+	switch value := value.(type) {
+	case common.Address:
+		return value.Hex()
+	case *big.Int:
+		return value.String()
+	case []byte:
+		return hexutil.Encode(value)
+	}
+	v := reflect.ValueOf(value)
+	if v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8 {
+		data := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(data), v)
+		return hexutil.Encode(data)
+	}
+	return fmt.Sprint(value)
+}
+
+// Get the name of the status as used in the test file.
+func statusName(status model.CompletionStatus) string {
+	for name, s := range completionStatuses {
+		if s == status {
+			return name
+		}
+	}
+	return "unprocessed"
+}
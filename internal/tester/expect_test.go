@@ -0,0 +1,155 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package tester
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/devnet"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+)
+
+type ExpectSuite struct {
+	suite.Suite
+}
+
+func TestExpectSuite(t *testing.T) {
+	suite.Run(t, new(ExpectSuite))
+}
+
+func (s *ExpectSuite) TestItParsesPayloadEncodings() {
+	suite := s.parse(`
+cases:
+  - inputs:
+      - payload: hello
+      - payload: "0xdeadbeef"
+      - payload: {json: {a: 1}}
+      - kind: inspect
+        payload: {text: "0x"}
+`)
+	inputs := suite.Cases[0].Inputs
+	s.Equal("case 0", suite.Cases[0].Name)
+	s.Equal([]byte("hello"), []byte(inputs[0].Payload))
+	s.Equal([]byte{0xde, 0xad, 0xbe, 0xef}, []byte(inputs[1].Payload))
+	s.Equal([]byte(`{"a":1}`), []byte(inputs[2].Payload))
+	s.Equal([]byte("0x"), []byte(inputs[3].Payload))
+	s.Equal(InputKindAdvance, inputs[0].Kind)
+	s.Equal(InputKindInspect, inputs[3].Kind)
+	s.Equal(devnet.SenderAddress, inputs[0].Sender)
+}
+
+func (s *ExpectSuite) TestItRejectsInvalidFiles() {
+	invalid := []string{
+		`cases: [{inputs: [{kind: foo}]}]`,
+		`cases: [{inputs: [{sender: 0x01}]}]`,
+		`cases: [{inputs: [{expect: {status: bar}}]}]`,
+		`cases: [{inputs: [{kind: inspect, expect: {notices: {count: 1}}}]}]`,
+		`cases: [{inputs: [{expect: {reports: {items: [{regex: "("}]}}}]}]`,
+		`cases: [{inputs: [{expect: {reports: {items: [{json: [{path: "a"}]}]}}}]}]`,
+		`cases: [{inputs: [{expect: {vouchers: {items: [{call: {signature: "f("}}]}}}]}]`,
+		`cases: [{unknown: field}]`,
+	}
+	for _, data := range invalid {
+		_, err := ParseSuite([]byte(data))
+		s.NotNil(err, data)
+	}
+}
+
+func (s *ExpectSuite) TestItChecksStatusAndCounts() {
+	expect := s.parseExpect(`{status: rejected, notices: {count: 1}, reports: {count: 0}}`)
+	input := model.AdvanceInput{
+		Status:  model.CompletionStatusAccepted,
+		Notices: []model.Notice{{Payload: []byte("a")}, {Payload: []byte("b")}},
+	}
+	failures := expect.checkAdvance(input)
+	s.Equal([]string{
+		"expected status rejected; got accepted",
+		"expected 1 notice(s); got 2",
+	}, failures)
+}
+
+func (s *ExpectSuite) TestItChecksPayloads() {
+	expect := s.parseExpect(`
+reports:
+  items:
+    - payload: hello
+    - regex: "^wor"
+    - json:
+        - {path: "$.a.b[1]", value: {c: true}}
+        - {path: "$.d", value: 1.5}
+    - payload: missing
+`)
+	input := model.InspectInput{
+		Reports: []model.Report{
+			{Payload: []byte("hello")},
+			{Payload: []byte("world")},
+			{Payload: []byte(`{"a": {"b": [0, {"c": true}]}, "d": 1.5}`)},
+		},
+	}
+	s.Equal([]string{"report 3: missing"}, expect.checkInspect(input))
+
+	input.Reports[0].Payload = []byte("bye")
+	input.Reports[1].Payload = []byte("hello world")
+	input.Reports[2].Payload = []byte(`{"a": {"b": [0]}, "d": 2}`)
+	failures := expect.checkInspect(input)
+	s.Len(failures, 5)
+}
+
+func (s *ExpectSuite) TestItChecksVoucherCalls() {
+	expect := s.parseExpect(`
+vouchers:
+  items:
+    - destination: "0x0000000000000000000000000000000000000001"
+      call:
+        signature: transfer(address,uint256)
+        args: ["0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", "100"]
+`)
+	method, err := parseCall("transfer(address,uint256)")
+	s.Require().Nil(err)
+	args, err := method.Inputs.Pack(common.HexToAddress(devnet.SenderAddress), big.NewInt(100))
+	s.Require().Nil(err)
+	input := model.AdvanceInput{
+		Vouchers: []model.Voucher{{
+			Destination: common.HexToAddress("0x01"),
+			Payload:     append(method.ID, args...),
+		}},
+	}
+	s.Empty(expect.checkAdvance(input))
+
+	args, err = method.Inputs.Pack(common.HexToAddress(devnet.SenderAddress), big.NewInt(99))
+	s.Require().Nil(err)
+	input.Vouchers[0].Payload = append(method.ID, args...)
+	input.Vouchers[0].Destination = common.HexToAddress("0x02")
+	s.Len(expect.checkAdvance(input), 2)
+
+	input.Vouchers[0].Payload = []byte{0xde, 0xad}
+	s.Len(expect.checkAdvance(input), 2)
+}
+
+func (s *ExpectSuite) TestItFormatsABIValues() {
+	s.Equal("0x0000000000000000000000000000000000000001",
+		formatABIValue(common.HexToAddress("0x01")))
+	s.Equal("42", formatABIValue(big.NewInt(42)))
+	s.Equal("0xbeef", formatABIValue([]byte{0xbe, 0xef}))
+	s.Equal("0x0102", formatABIValue([2]byte{1, 2}))
+	s.Equal("true", formatABIValue(true))
+	s.Equal("hi", formatABIValue("hi"))
+}
+
+func (s *ExpectSuite) parse(data string) *Suite {
+	suite, err := ParseSuite([]byte(data))
+	s.Require().Nil(err)
+	return suite
+}
+
+func (s *ExpectSuite) parseExpect(data string) *Expect {
+	var expect Expect
+	s.Require().Nil(yaml.Unmarshal([]byte(data), &expect))
+	s.Require().Nil(expect.validate())
+	return &expect
+}
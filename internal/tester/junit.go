@@ -0,0 +1,86 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+package tester
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// Write the results in the JUnit XML format.
+func WriteJUnit(w io.Writer, suiteName string, results []CaseResult) error {
+	suite := junitTestSuite{
+		Name:  suiteName,
+		Tests: len(results),
+	}
+	var total time.Duration
+	for _, result := range results {
+		total += result.Duration
+		testCase := junitTestCase{
+			Name:      result.Name,
+			Classname: suiteName,
+			Time:      formatSeconds(result.Duration),
+		}
+		if result.Error != nil {
+			suite.Errors++
+			testCase.Error = &junitMessage{
+				Message: result.Error.Error(),
+				Text:    result.Error.Error(),
+			}
+		} else if len(result.Failures) > 0 {
+			suite.Failures++
+			testCase.Failure = &junitMessage{
+				Message: fmt.Sprintf("%v failed check(s)", len(result.Failures)),
+				Text:    strings.Join(result.Failures, "\n"),
+			}
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+	suite.Time = formatSeconds(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func formatSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,206 @@
+// Copyright (c) Gabriel de Quadros Ligneul
+// SPDX-License-Identifier: Apache-2.0 (see LICENSE)
+
+// This package contains a declarative test runner for application back-ends.
+// The runner sends the inputs directly to the nonodo model, so it doesn't need Anvil.
+package tester
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gligneul/nonodo/internal/model"
+	"github.com/gligneul/nonodo/internal/nonodo"
+)
+
+// Default timeout when waiting for the application to process an input.
+const DefaultInputTimeout = 10 * time.Second
+
+// Poll interval when waiting for the application to process an input.
+const inputPollInterval = 10 * time.Millisecond
+
+// Result of a test case.
+type CaseResult struct {
+	Name     string
+	Duration time.Duration
+
+	// Failed checks.
+	Failures []string
+
+	// Error that prevented the test case from running until the end.
+	Error error
+}
+
+// Return whether the test case passed.
+func (r CaseResult) Passed() bool {
+	return r.Error == nil && len(r.Failures) == 0
+}
+
+// Runs the test cases against the application.
+// Each test case starts a new instance of nonodo and the application.
+type Tester struct {
+	Opts         nonodo.NonodoOpts
+	InputTimeout time.Duration
+}
+
+// Run all cases in the suite.
+func (t Tester) Run(ctx context.Context, suite *Suite) []CaseResult {
+	results := make([]CaseResult, len(suite.Cases))
+	for i := range suite.Cases {
+		c := &suite.Cases[i]
+		slog.Info("tester: running case", "name", c.Name)
+		start := time.Now()
+		failures, err := t.runCase(ctx, c)
+		results[i] = CaseResult{
+			Name:     c.Name,
+			Duration: time.Since(start),
+			Failures: failures,
+			Error:    err,
+		}
+		if results[i].Passed() {
+			slog.Info("tester: case passed", "name", c.Name)
+		} else {
+			slog.Error("tester: case failed", "name", c.Name, "failures", failures, "error", err)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return results
+}
+
+// Start nonodo and send the inputs of the test case.
+func (t Tester) runCase(ctx context.Context, c *Case) ([]string, error) {
+	m, exited, stop, err := t.start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stop()
+
+	var failures []string
+	for i := range c.Inputs {
+		input := &c.Inputs[i]
+		var inputFailures []string
+		var err error
+		switch input.Kind {
+		case InputKindAdvance:
+			inputFailures, err = t.runAdvance(ctx, m, exited, i, input)
+		case InputKindInspect:
+			inputFailures, err = t.runInspect(ctx, m, exited, input)
+		}
+		if err != nil {
+			return failures, fmt.Errorf("input %v: %w", i, err)
+		}
+		for _, failure := range inputFailures {
+			failures = append(failures, fmt.Sprintf("input %v: %v", i, failure))
+		}
+	}
+	return failures, nil
+}
+
+// Start a new instance of nonodo and wait until it is ready.
+// Return the model, a channel closed when nonodo exits, and the function that stops nonodo.
+func (t Tester) start(
+	ctx context.Context,
+) (*model.NonodoModel, <-chan struct{}, func(), error) {
+	ctx, cancel := context.WithCancel(ctx)
+	opts := t.Opts
+	opts.DisableInputter = true
+	m := model.NewNonodoModel()
+	w := nonodo.NewSupervisorWithModel(opts, m)
+
+	ready := make(chan struct{}, 1)
+	exited := make(chan struct{})
+	var exitErr error
+	go func() {
+		defer close(exited)
+		exitErr = w.Start(ctx, ready)
+	}()
+	stop := func() {
+		cancel()
+		<-exited
+	}
+	select {
+	case <-ready:
+		return m, exited, stop, nil
+	case <-exited:
+		stop()
+		return nil, nil, nil, fmt.Errorf("nonodo exited before being ready: %v", exitErr)
+	case <-ctx.Done():
+		stop()
+		return nil, nil, nil, ctx.Err()
+	}
+}
+
+func (t Tester) runAdvance(
+	ctx context.Context,
+	m *model.NonodoModel,
+	exited <-chan struct{},
+	position int,
+	input *Input,
+) ([]string, error) {
+	index := m.GetNumInputs(model.InputFilter{})
+	m.AddAdvanceInput(
+		common.HexToAddress(input.Sender),
+		input.Payload,
+		uint64(position),
+		time.Now(),
+	)
+	var result model.AdvanceInput
+	err := t.waitProcessed(ctx, exited, func() bool {
+		result, _ = m.GetAdvanceInput(index)
+		return result.Status != model.CompletionStatusUnprocessed
+	})
+	if err != nil {
+		return nil, err
+	}
+	return input.Expect.checkAdvance(result), nil
+}
+
+func (t Tester) runInspect(
+	ctx context.Context,
+	m *model.NonodoModel,
+	exited <-chan struct{},
+	input *Input,
+) ([]string, error) {
+	index := m.AddInspectInput(input.Payload)
+	var result model.InspectInput
+	err := t.waitProcessed(ctx, exited, func() bool {
+		result = m.GetInspectInput(index)
+		return result.Status != model.CompletionStatusUnprocessed
+	})
+	if err != nil {
+		return nil, err
+	}
+	return input.Expect.checkInspect(result), nil
+}
+
+// Poll the processed function until it returns true.
+func (t Tester) waitProcessed(
+	ctx context.Context,
+	exited <-chan struct{},
+	processed func() bool,
+) error {
+	timeout := t.InputTimeout
+	if timeout == 0 {
+		timeout = DefaultInputTimeout
+	}
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(inputPollInterval)
+	defer ticker.Stop()
+	for !processed() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-exited:
+			return fmt.Errorf("nonodo exited before processing the input")
+		case <-deadline:
+			return fmt.Errorf("timed out waiting for the application")
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
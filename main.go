@@ -6,16 +6,25 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/carlmjohnson/versioninfo"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/gligneul/nonodo/internal/inputter"
+	"github.com/gligneul/nonodo/internal/mockapp"
 	"github.com/gligneul/nonodo/internal/nonodo"
+	"github.com/gligneul/nonodo/internal/rollup"
+	"github.com/gligneul/nonodo/internal/rollupcli"
+	"github.com/gligneul/nonodo/internal/supervisor"
+	"github.com/gligneul/nonodo/internal/tester"
 	"github.com/lmittmann/tint"
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
@@ -28,9 +37,54 @@ var cmd = &cobra.Command{
 	Version: versioninfo.Short(),
 }
 
+var testCmd = &cobra.Command{
+	Use:   "test cases.yaml [flags] [-- application [args]...]",
+	Short: "Run the test cases against the application and print a JUnit XML report",
+	Args:  cobra.MinimumNArgs(1),
+	Run:   runTest,
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff graphql-url [flags] [-- application [args]...]",
+	Short: "Replay the inputs of a deployed node and report the results that differ",
+	Long: `Replay the inputs of a deployed node and report the results that differ.
+The command fetches the processed inputs and their outputs from the reader GraphQL endpoint of the
+node, sends them to the application through nonodo, and prints the inputs whose status or outputs
+differ from the results of the node.`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  runDiff,
+}
+
+var rollupCmd = &cobra.Command{
+	Use:   "rollup command",
+	Short: "Emulate the rollup command of the Cartesi machine using the nonodo rollup API",
+	Long: `Emulate the rollup command of the Cartesi machine using the nonodo rollup API.
+The command reads the URL of the rollup API from the ROLLUP_HTTP_SERVER_URL environment variable.
+When the nonodo binary is called rollup, it behaves like this command.
+
+Commands:
+  voucher    read {"destination": <address>, "payload": <hex>} from stdin and print {"index": <n>}
+  notice     read {"payload": <hex>} from stdin and print {"index": <n>}
+  report     read {"payload": <hex>} from stdin
+  exception  read {"payload": <hex>} from stdin
+  finish     read {"status": "accept" | "reject"} from stdin and print the next request
+  accept     accept the current request and print the next request
+  reject     reject the current request and print the next request`,
+	Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+	ValidArgs: rollupcli.Commands,
+	Run:       runRollup,
+}
+
 var debug bool
 var color bool
 var opts = nonodo.NewNonodoOpts()
+var testOpts = nonodo.NewNonodoOpts()
+var mockBackend string
+var testOutput string
+var testInputTimeout time.Duration
+var diffOpts = nonodo.NewNonodoOpts()
+var diffInputTimeout time.Duration
+var diffLimit int
 
 func init() {
 	// anvil-*
@@ -39,6 +93,14 @@ func init() {
 	cmd.Flags().BoolVar(&opts.AnvilVerbose, "anvil-verbose", opts.AnvilVerbose,
 		"If set, prints Anvil's output")
 
+	// build
+	cmd.Flags().StringVar(&opts.WatchBuild, "build", opts.WatchBuild,
+		"If set, run this shell command to build the application before restarting it")
+
+	// check-*
+	cmd.Flags().BoolVar(&opts.CheckDeterminism, "check-determinism", opts.CheckDeterminism,
+		"If set, run a replica of the application and compare their outputs for each advance")
+
 	// contracts-*
 	cmd.Flags().StringVar(&opts.ApplicationAddress, "contracts-application-address",
 		opts.ApplicationAddress, "Application contract address")
@@ -48,8 +110,9 @@ func init() {
 		opts.InputBoxBlock, "InputBox deployment block number")
 
 	// enable-*
-	cmd.Flags().BoolVarP(&debug, "enable-debug", "d", false, "If set, enable debug output")
-	cmd.Flags().BoolVar(&color, "enable-color", true, "If set, enables logs color")
+	cmd.PersistentFlags().BoolVarP(&debug, "enable-debug", "d", false,
+		"If set, enable debug output")
+	cmd.PersistentFlags().BoolVar(&color, "enable-color", true, "If set, enables logs color")
 	cmd.Flags().BoolVar(&opts.EnableEcho, "enable-echo", opts.EnableEcho,
 		"If set, nonodo starts a built-in echo application")
 
@@ -59,25 +122,136 @@ func init() {
 	cmd.Flags().IntVar(&opts.HttpPort, "http-port", opts.HttpPort,
 		"HTTP port used by nonodo to serve its APIs")
 
-	// rpc-url
+	// input-*
+	cmd.Flags().Var(&latencyValue{&opts.InputLatency}, "input-latency",
+		"Delay of the new inputs, like 12s, 5s-30s for a random delay, or 3blocks")
+
+	// inspect-*
+	cmd.Flags().IntVar(&opts.InspectReplicas, "inspect-replicas", opts.InspectReplicas,
+		"Number of application replicas that serve the inspects without waiting for the advances")
+
+	// libcmt-*
+	cmd.Flags().BoolVar(&opts.Libcmt, "libcmt", opts.Libcmt,
+		"If set, run the application with the mock mode of libcmt instead of the rollup API")
+	cmd.Flags().Uint64Var(&opts.LibcmtChainID, "libcmt-chain-id", opts.LibcmtChainID,
+		"Chain id sent to the libcmt application in the advance inputs")
+
+	// limit-*
+	cmd.Flags().Var(&limitModeValue{&opts.LimitMode}, "limit-mode",
+		"Mechanism used to limit the application resources: none, rlimit, or cgroup")
+	cmd.Flags().Var(&byteSizeValue{&opts.LimitMemory}, "limit-memory",
+		"Memory limit of the application; defaults to the machine RAM size")
+	cmd.Flags().Float64Var(&opts.LimitCPU, "limit-cpu", opts.LimitCPU,
+		"CPU limit of the application in number of CPUs; defaults to 1")
+	cmd.Flags().Uint64Var(&opts.LimitOpenFiles, "limit-open-files", opts.LimitOpenFiles,
+		"Maximum number of open files of the application; defaults to the machine default")
+
+	// machine-*
+	cmd.Flags().Var(&byteSizeValue{&opts.MachineRAMSize}, "machine-ram-size",
+		"RAM size of the target Cartesi machine, used to derive the resource limits")
+
+	// mock-backend
+	cmd.Flags().StringVar(&mockBackend, "mock-backend", "",
+		"If set, nonodo starts a mock application that responds following the rules in this file")
+
+	// restart-*
+	cmd.Flags().Var(&restartPolicyValue{&opts.RestartApp}, "restart-app",
+		"Restart policy for the application: never, on-failure, or always")
+	cmd.Flags().Var(&restartPolicyValue{&opts.RestartInputter}, "restart-inputter",
+		"Restart policy for the inputter: never, on-failure, or always")
+	cmd.Flags().IntVar(&opts.RestartMax, "restart-max", opts.RestartMax,
+		"Maximum number of restarts for each worker; 0 means no limit")
+	cmd.Flags().DurationVar(&opts.RestartBackoff, "restart-backoff", opts.RestartBackoff,
+		"Delay before the first restart, which doubles after each restart")
+
+	// rollup-*
+	cmd.Flags().Var(&faultsValue{faults: &opts.RollupFaults}, "rollup-faults",
+		"Probabilities of injecting faults in the rollup API, like latency=0.1,error=0.05")
+	cmd.Flags().IntVar(&opts.RollupJournalSize, "rollup-journal-size", opts.RollupJournalSize,
+		"Number of rollup API exchanges kept in the journal; 0 disables the journal")
+
+	// rpc-*
 	cmd.Flags().StringVar(&opts.RpcUrl, "rpc-url", opts.RpcUrl,
 		"If set, nonodo connects to this url instead of setting up Anvil")
+	cmd.Flags().BoolVar(&opts.RpcCache, "rpc-cache", opts.RpcCache,
+		"If set, nonodo caches the inputs fetched from --rpc-url and resumes from the last block")
+	cmd.Flags().StringVar(&opts.RpcCacheDir, "rpc-cache-dir", opts.RpcCacheDir,
+		"Directory of the --rpc-cache; empty uses the user cache directory")
+	cmd.Flags().Uint64Var(&opts.RpcConfirmationDepth, "rpc-confirmation-depth",
+		opts.RpcConfirmationDepth,
+		"Number of blocks nonodo waits after the block of an input before processing it")
+	cmd.Flags().DurationVar(&opts.RpcPollInterval, "rpc-poll-interval", opts.RpcPollInterval,
+		"Interval between checks for new blocks in the Ethereum node")
+
+	// sandbox-*
+	cmd.Flags().BoolVar(&opts.Sandbox, "sandbox", opts.Sandbox,
+		"If set, run the application in a Linux sandbox without network access")
+	cmd.Flags().Var(&sandboxFSValue{&opts.SandboxFS}, "sandbox-fs",
+		"File system view inside the sandbox: host, readonly, or private")
+
+	// sequencer-*
+	cmd.Flags().BoolVar(&opts.Sequencer, "sequencer", opts.Sequencer,
+		"If set, accept EIP-712 signed messages from the local sequencer as advance inputs")
+	cmd.Flags().Uint64Var(&opts.SequencerChainID, "sequencer-chain-id", opts.SequencerChainID,
+		"Chain id in the EIP-712 domain of the sequencer messages")
+
+	// server-manager-*
+	cmd.Flags().StringVar(&opts.ServerManagerAddress, "server-manager-address",
+		opts.ServerManagerAddress,
+		"If set, process the inputs in a Cartesi machine through the server-manager at this address")
+	cmd.Flags().StringVar(&opts.ServerManagerSession, "server-manager-session",
+		opts.ServerManagerSession, "Id of the server-manager session")
+	cmd.Flags().StringVar(&opts.ServerManagerMachine, "server-manager-machine",
+		opts.ServerManagerMachine,
+		"If set, start the server-manager session with the machine snapshot in this directory")
+
+	// slowdown
+	cmd.Flags().Float64Var(&opts.Slowdown, "slowdown", opts.Slowdown,
+		"If set, throttle the application so it runs this many times slower")
+
+	// strict
+	cmd.Flags().BoolVar(&opts.Strict, "strict", opts.Strict,
+		"If set, check the application requests against the rollup API spec and report violations")
+
+	// watch-*
+	cmd.Flags().StringArrayVar(&opts.WatchPatterns, "watch", opts.WatchPatterns,
+		"If set, restart the application when the files matching this glob change")
+	cmd.Flags().BoolVar(&opts.WatchReplay, "watch-replay", opts.WatchReplay,
+		"If set, the application processes all advance inputs again after restarting")
+
+	// diff command
+	cmd.AddCommand(diffCmd)
+	diffCmd.Flags().BoolVar(&diffOpts.EnableEcho, "enable-echo", diffOpts.EnableEcho,
+		"If set, replay the inputs in the built-in echo application")
+	diffCmd.Flags().StringVar(&diffOpts.HttpAddress, "http-address", diffOpts.HttpAddress,
+		"HTTP address used by nonodo to serve its APIs")
+	diffCmd.Flags().IntVar(&diffOpts.HttpPort, "http-port", diffOpts.HttpPort,
+		"HTTP port used by nonodo to serve its APIs")
+	diffCmd.Flags().DurationVar(&diffInputTimeout, "input-timeout", tester.DefaultInputTimeout,
+		"Timeout when waiting for the application to process an input")
+	diffCmd.Flags().IntVar(&diffLimit, "limit", 0,
+		"If set, replay at most this number of inputs")
+
+	// rollup command
+	cmd.AddCommand(rollupCmd)
+
+	// test command
+	cmd.AddCommand(testCmd)
+	testCmd.Flags().BoolVar(&testOpts.EnableEcho, "enable-echo", testOpts.EnableEcho,
+		"If set, run the tests against the built-in echo application")
+	testCmd.Flags().StringVar(&testOpts.HttpAddress, "http-address", testOpts.HttpAddress,
+		"HTTP address used by nonodo to serve its APIs")
+	testCmd.Flags().IntVar(&testOpts.HttpPort, "http-port", testOpts.HttpPort,
+		"HTTP port used by nonodo to serve its APIs")
+	testCmd.Flags().DurationVar(&testInputTimeout, "input-timeout", tester.DefaultInputTimeout,
+		"Timeout when waiting for the application to process an input")
+	testCmd.Flags().StringVarP(&testOutput, "output", "o", "",
+		"If set, write the JUnit XML report to this file instead of stdout")
 }
 
 func run(cmd *cobra.Command, args []string) {
 	var startTime = time.Now()
-
-	// setup log
-	logOpts := new(tint.Options)
-	if debug {
-		logOpts.Level = slog.LevelDebug
-	}
-	logOpts.AddSource = debug
-	logOpts.NoColor = !color || !isatty.IsTerminal(os.Stdout.Fd())
-	logOpts.TimeFormat = "[15:04:05.000]"
-	handler := tint.NewHandler(os.Stdout, logOpts)
-	logger := slog.New(handler)
-	slog.SetDefault(logger)
+	setupLog(os.Stdout)
 
 	// check args
 	checkEthAddress(cmd, "address-input-box")
@@ -88,9 +262,112 @@ func run(cmd *cobra.Command, args []string) {
 	if cmd.Flags().Changed("rpc-url") && !cmd.Flags().Changed("contracts-input-box-block") {
 		exitf("must set --contracts-input-box-block when setting --rpc-url")
 	}
+	if opts.RpcCache && !cmd.Flags().Changed("rpc-url") {
+		exitf("must set --rpc-url when setting --rpc-cache")
+	}
+	if cmd.Flags().Changed("rpc-cache-dir") && !opts.RpcCache {
+		exitf("must set --rpc-cache when setting --rpc-cache-dir")
+	}
+	if opts.RpcCache && opts.RpcCacheDir == "" && inputter.DefaultCacheDir() == "" {
+		exitf("no user cache directory; set --rpc-cache-dir")
+	}
+	if opts.RpcPollInterval <= 0 {
+		exitf("--rpc-poll-interval must be positive")
+	}
 	if opts.EnableEcho && len(args) > 0 {
 		exitf("can't use built-in echo with custom application")
 	}
+	if mockBackend != "" {
+		if opts.EnableEcho || len(args) > 0 {
+			exitf("can't use --mock-backend with built-in echo or custom application")
+		}
+		rules, err := mockapp.LoadRules(mockBackend)
+		if err != nil {
+			exitf("%v", err)
+		}
+		opts.MockBackend = rules
+	}
+	if opts.ServerManagerAddress != "" {
+		if opts.EnableEcho || mockBackend != "" || len(args) > 0 {
+			exitf("can't use --server-manager-address with built-in echo, mock back-end, " +
+				"or custom application")
+		}
+	} else if cmd.Flags().Changed("server-manager-session") ||
+		cmd.Flags().Changed("server-manager-machine") {
+		exitf("must set --server-manager-address when setting the server-manager options")
+	}
+	if opts.CheckDeterminism {
+		if len(args) == 0 {
+			exitf("must set the application command when setting --check-determinism")
+		}
+		if len(opts.WatchPatterns) > 0 {
+			exitf("can't use --check-determinism with --watch")
+		}
+	}
+	if opts.InspectReplicas < 0 {
+		exitf("--inspect-replicas cannot be negative")
+	}
+	if opts.InspectReplicas > 0 {
+		if len(args) == 0 {
+			exitf("must set the application command when setting --inspect-replicas")
+		}
+		if len(opts.WatchPatterns) > 0 {
+			exitf("can't use --inspect-replicas with --watch")
+		}
+	}
+	if opts.Libcmt {
+		if len(args) == 0 {
+			exitf("must set the application command when setting --libcmt")
+		}
+		if opts.CheckDeterminism || opts.InspectReplicas > 0 || opts.Sandbox ||
+			len(opts.WatchPatterns) > 0 {
+			exitf("can't use --libcmt with --check-determinism, --inspect-replicas, " +
+				"--sandbox, or --watch")
+		}
+	} else if cmd.Flags().Changed("libcmt-chain-id") {
+		exitf("must set --libcmt when setting --libcmt-chain-id")
+	}
+	if opts.LimitMode != supervisor.LimitNone {
+		if len(args) == 0 {
+			exitf("must set the application command when setting --limit-mode")
+		}
+		if opts.LimitMode == supervisor.LimitRlimit && cmd.Flags().Changed("limit-cpu") {
+			exitf("must set --limit-mode cgroup when setting --limit-cpu")
+		}
+		if !cmd.Flags().Changed("restart-app") {
+			opts.RestartApp = supervisor.RestartOnFailure
+		}
+	} else if cmd.Flags().Changed("limit-memory") || cmd.Flags().Changed("limit-cpu") ||
+		cmd.Flags().Changed("limit-open-files") || cmd.Flags().Changed("machine-ram-size") {
+		exitf("must set --limit-mode when setting the resource limits")
+	}
+	if opts.RollupJournalSize < 0 {
+		exitf("--rollup-journal-size cannot be negative")
+	}
+	if opts.Sandbox && len(args) == 0 {
+		exitf("must set the application command when setting --sandbox")
+	}
+	if !opts.Sandbox && cmd.Flags().Changed("sandbox-fs") {
+		exitf("must set --sandbox when setting --sandbox-fs")
+	}
+	if !opts.Sequencer && cmd.Flags().Changed("sequencer-chain-id") {
+		exitf("must set --sequencer when setting --sequencer-chain-id")
+	}
+	if cmd.Flags().Changed("slowdown") {
+		if len(args) == 0 {
+			exitf("must set the application command when setting --slowdown")
+		}
+		if opts.Slowdown < 1 {
+			exitf("--slowdown must be at least 1")
+		}
+	}
+	if len(opts.WatchPatterns) > 0 && len(args) == 0 {
+		exitf("must set the application command when setting --watch")
+	}
+	if len(opts.WatchPatterns) == 0 &&
+		(cmd.Flags().Changed("build") || cmd.Flags().Changed("watch-replay")) {
+		exitf("must set --watch when setting --build or --watch-replay")
+	}
 	opts.ApplicationArgs = args
 
 	// handle signals with notify context
@@ -110,16 +387,261 @@ func run(cmd *cobra.Command, args []string) {
 	cobra.CheckErr(err)
 }
 
+func runTest(cmd *cobra.Command, args []string) {
+	// the report may go to stdout, so the logs go to stderr
+	setupLog(os.Stderr)
+
+	// check args
+	suite, err := tester.LoadSuite(args[0])
+	cobra.CheckErr(err)
+	testOpts.ApplicationArgs = args[1:]
+	if testOpts.EnableEcho && len(testOpts.ApplicationArgs) > 0 {
+		exitf("can't use built-in echo with custom application")
+	}
+	if !testOpts.EnableEcho && len(testOpts.ApplicationArgs) == 0 {
+		exitf("missing application command after --")
+	}
+
+	// handle signals with notify context
+	ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// run the tests
+	t := tester.Tester{
+		Opts:         testOpts,
+		InputTimeout: testInputTimeout,
+	}
+	results := t.Run(ctx, suite)
+
+	// write the report
+	var output io.WriteCloser = os.Stdout
+	if testOutput != "" {
+		output, err = os.Create(testOutput)
+		cobra.CheckErr(err)
+	}
+	cobra.CheckErr(tester.WriteJUnit(output, args[0], results))
+	cobra.CheckErr(output.Close())
+	for _, result := range results {
+		if !result.Passed() {
+			os.Exit(1)
+		}
+	}
+}
+
+func runDiff(cmd *cobra.Command, args []string) {
+	// the differences go to stdout, so the logs go to stderr
+	setupLog(os.Stderr)
+
+	// check args
+	diffOpts.ApplicationArgs = args[1:]
+	if diffOpts.EnableEcho && len(diffOpts.ApplicationArgs) > 0 {
+		exitf("can't use built-in echo with custom application")
+	}
+	if !diffOpts.EnableEcho && len(diffOpts.ApplicationArgs) == 0 {
+		exitf("missing application command after --")
+	}
+	if diffLimit < 0 {
+		exitf("--limit cannot be negative")
+	}
+
+	// handle signals with notify context
+	ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// replay the inputs
+	inputs, err := tester.FetchHistory(ctx, args[0], diffLimit)
+	cobra.CheckErr(err)
+	slog.Info("nonodo: replaying inputs", "count", len(inputs))
+	t := tester.Tester{
+		Opts:         diffOpts,
+		InputTimeout: diffInputTimeout,
+	}
+	diffs, err := t.Diff(ctx, inputs)
+	for _, diff := range diffs {
+		for _, difference := range diff.Differences {
+			fmt.Printf("input %v: %v\n", diff.Index, difference)
+		}
+	}
+	cobra.CheckErr(err)
+	if len(diffs) > 0 {
+		os.Exit(1)
+	}
+	slog.Info("nonodo: no differences found", "count", len(inputs))
+}
+
+func runRollup(cmd *cobra.Command, args []string) {
+	// handle signals with notify context
+	ctx, cancel := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	err := rollupcli.Run(ctx, rollupcli.Endpoint(), args[0], os.Stdin, os.Stdout)
+	cobra.CheckErr(err)
+}
+
 func main() {
+	// when running as the init process of a command, this function executes the command
+	supervisor.Init()
+	// when the binary is called rollup, behave like the rollup command
+	name := filepath.Base(os.Args[0])
+	if strings.TrimSuffix(name, filepath.Ext(name)) == rollupCmd.Name() {
+		cmd.SetArgs(append([]string{rollupCmd.Name()}, os.Args[1:]...))
+	}
 	cobra.CheckErr(cmd.Execute())
 }
 
+func setupLog(w *os.File) {
+	logOpts := new(tint.Options)
+	if debug {
+		logOpts.Level = slog.LevelDebug
+	}
+	logOpts.AddSource = debug
+	logOpts.NoColor = !color || !isatty.IsTerminal(w.Fd())
+	logOpts.TimeFormat = "[15:04:05.000]"
+	handler := tint.NewHandler(w, logOpts)
+	logger := slog.New(handler)
+	slog.SetDefault(logger)
+}
+
 func exitf(format string, args ...any) {
 	err := fmt.Sprintf(format, args...)
 	slog.Error("configuration error", "error", err)
 	os.Exit(1)
 }
 
+// Flag value for restart policies.
+type restartPolicyValue struct {
+	policy *supervisor.RestartPolicy
+}
+
+func (v *restartPolicyValue) String() string {
+	return string(*v.policy)
+}
+
+func (v *restartPolicyValue) Set(value string) error {
+	policy, err := supervisor.ParseRestartPolicy(value)
+	if err != nil {
+		return err
+	}
+	*v.policy = policy
+	return nil
+}
+
+func (v *restartPolicyValue) Type() string {
+	return "policy"
+}
+
+// Flag value for the limit mode.
+type limitModeValue struct {
+	mode *supervisor.LimitMode
+}
+
+func (v *limitModeValue) String() string {
+	return string(*v.mode)
+}
+
+func (v *limitModeValue) Set(value string) error {
+	mode, err := supervisor.ParseLimitMode(value)
+	if err != nil {
+		return err
+	}
+	*v.mode = mode
+	return nil
+}
+
+func (v *limitModeValue) Type() string {
+	return "mode"
+}
+
+// Flag value for sizes in bytes.
+type byteSizeValue struct {
+	size *int64
+}
+
+func (v *byteSizeValue) String() string {
+	return supervisor.FormatByteSize(*v.size)
+}
+
+func (v *byteSizeValue) Set(value string) error {
+	size, err := supervisor.ParseByteSize(value)
+	if err != nil {
+		return err
+	}
+	*v.size = size
+	return nil
+}
+
+func (v *byteSizeValue) Type() string {
+	return "size"
+}
+
+// Flag value for the sandbox file system view.
+type sandboxFSValue struct {
+	fs *supervisor.SandboxFS
+}
+
+func (v *sandboxFSValue) String() string {
+	return string(*v.fs)
+}
+
+func (v *sandboxFSValue) Set(value string) error {
+	fs, err := supervisor.ParseSandboxFS(value)
+	if err != nil {
+		return err
+	}
+	*v.fs = fs
+	return nil
+}
+
+func (v *sandboxFSValue) Type() string {
+	return "view"
+}
+
+// Flag value for the rollup API faults.
+type faultsValue struct {
+	faults *rollup.Faults
+	spec   string
+}
+
+func (v *faultsValue) String() string {
+	return v.spec
+}
+
+func (v *faultsValue) Set(value string) error {
+	faults, err := rollup.ParseFaults(value)
+	if err != nil {
+		return err
+	}
+	*v.faults = faults
+	v.spec = value
+	return nil
+}
+
+func (v *faultsValue) Type() string {
+	return "faults"
+}
+
+// Flag value for the input latency.
+type latencyValue struct {
+	latency *inputter.Latency
+}
+
+func (v *latencyValue) String() string {
+	return v.latency.String()
+}
+
+func (v *latencyValue) Set(value string) error {
+	latency, err := inputter.ParseLatency(value)
+	if err != nil {
+		return err
+	}
+	*v.latency = latency
+	return nil
+}
+
+func (v *latencyValue) Type() string {
+	return "latency"
+}
+
 func checkEthAddress(cmd *cobra.Command, varName string) {
 	if cmd.Flags().Changed(varName) {
 		value, err := cmd.Flags().GetString(varName)
@@ -8,4 +8,6 @@ import (
 	_ "github.com/99designs/gqlgen/graphql/introspection"
 	_ "github.com/Khan/genqlient"
 	_ "github.com/deepmap/oapi-codegen/v2/cmd/oapi-codegen"
+	_ "google.golang.org/grpc/cmd/protoc-gen-go-grpc"
+	_ "google.golang.org/protobuf/cmd/protoc-gen-go"
 )